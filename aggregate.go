@@ -0,0 +1,198 @@
+package collections
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/blendlabs/go-exception"
+)
+
+// AggregateAction combines the accumulated value with the next element.
+type AggregateAction func(accumulator, value interface{}) interface{}
+
+// Count returns the number of elements that match the predicate; a nil predicate counts every element.
+func Count(collection Enumerable, predicate Predicate) (int, error) {
+	if typedCollection, isList := collection.(*List); isList && predicate == nil {
+		return typedCollection.Len(), nil
+	}
+
+	count := 0
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		if predicate == nil || predicate(e.GetCurrent()) {
+			count = count + 1
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Sum adds up the elements of the collection, which may be any mix of numeric types. Integers are added
+// exactly, and the total is only rounded to a float64 at the end.
+func Sum(collection Enumerable) (float64, error) {
+	sum := &numericSum{}
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		if addErr := sum.Add(e.GetCurrent()); addErr != nil {
+			return 0, addErr
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return 0, err
+	}
+	return sum.Float64(), nil
+}
+
+// Average returns the mean of the elements of the collection, which may be any mix of numeric types,
+// added up as Sum does.
+func Average(collection Enumerable) (float64, error) {
+	sum := &numericSum{}
+	count := 0
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		if addErr := sum.Add(e.GetCurrent()); addErr != nil {
+			return 0, addErr
+		}
+		count = count + 1
+	}
+	if err := enumeratorErr(e); err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, ErrNoElements
+	}
+	return sum.Float64() / float64(count), nil
+}
+
+// Min returns the smallest element, compared with the comparer for the type of the first element;
+// numbers of mixed types are compared exactly.
+func Min(collection Enumerable) (interface{}, error) {
+	return extremeBy(collection, DefaultKeySelector, -1)
+}
+
+// Max returns the largest element, compared with the comparer for the type of the first element;
+// numbers of mixed types are compared exactly.
+func Max(collection Enumerable) (interface{}, error) {
+	return extremeBy(collection, DefaultKeySelector, 1)
+}
+
+// MinBy returns the first element with the smallest key.
+func MinBy(collection Enumerable, keySelector KeySelector) (interface{}, error) {
+	return extremeBy(collection, keySelector, -1)
+}
+
+// MaxBy returns the first element with the largest key.
+func MaxBy(collection Enumerable, keySelector KeySelector) (interface{}, error) {
+	return extremeBy(collection, keySelector, 1)
+}
+
+// Aggregate folds the collection into a single value, starting from seed.
+func Aggregate(collection Enumerable, seed interface{}, fn AggregateAction) (interface{}, error) {
+	accumulator := seed
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		accumulator = fn(accumulator, e.GetCurrent())
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return accumulator, nil
+}
+
+// Fold is Aggregate with the first element as the seed; it returns ErrNoElements for an empty collection.
+func Fold(collection Enumerable, fn AggregateAction) (interface{}, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	if !e.MoveNext() {
+		if err := enumeratorErr(e); err != nil {
+			return nil, err
+		}
+		return nil, ErrNoElements
+	}
+
+	accumulator := e.GetCurrent()
+	for e.MoveNext() {
+		accumulator = fn(accumulator, e.GetCurrent())
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return accumulator, nil
+}
+
+// extremeBy returns the first element whose key compares to every other key with the sign of direction (or equal).
+func extremeBy(collection Enumerable, keySelector KeySelector, direction int) (interface{}, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	if !e.MoveNext() {
+		if err := enumeratorErr(e); err != nil {
+			return nil, err
+		}
+		return nil, ErrNoElements
+	}
+
+	best := e.GetCurrent()
+	bestKey := keySelector(best)
+	var comparer Comparer
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		currentKey := keySelector(current)
+
+		if comparer == nil {
+			// until a key is not nil there is no type to pick the comparer from, and nil keys are all equal
+			if bestKey == nil && currentKey == nil {
+				continue
+			}
+			var comparerErr error
+			comparer, comparerErr = getComparerForValues([]interface{}{bestKey, currentKey})
+			if comparerErr != nil {
+				return nil, comparerErr
+			}
+		}
+
+		compareResult, compareErr := compareNilFirst(comparer, currentKey, bestKey)
+		if compareErr != nil {
+			return nil, compareErr
+		}
+		if compareResult*direction > 0 {
+			best, bestKey = current, currentKey
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return best, nil
+}
+
+// numericSum adds up numbers of any mix of numeric types, keeping the integers exact.
+type numericSum struct {
+	integers big.Int
+	floats   float64
+}
+
+func (ns *numericSum) Add(value interface{}) error {
+	valueValue := reflect.ValueOf(value)
+	switch {
+	case value == nil || !isNumericKind(valueValue.Kind()):
+		return exception.Newf("Cannot add %v", reflect.TypeOf(value))
+	case isFloatKind(valueValue.Kind()):
+		ns.floats = ns.floats + valueValue.Float()
+	case isSignedKind(valueValue.Kind()):
+		ns.integers.Add(&ns.integers, new(big.Int).SetInt64(valueValue.Int()))
+	default:
+		ns.integers.Add(&ns.integers, new(big.Int).SetUint64(valueValue.Uint()))
+	}
+	return nil
+}
+
+func (ns *numericSum) Float64() float64 {
+	integers, _ := new(big.Float).SetInt(&ns.integers).Float64()
+	return integers + ns.floats
+}
@@ -0,0 +1,158 @@
+package collections
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestCount(t *testing.T) {
+	a := assert.New(t)
+
+	count := must[int](a)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal(5, count(Count(l, nil)))
+	a.Equal(2, count(Count(l, func(value interface{}) bool {
+		return value.(int)%2 == 0
+	})))
+	a.Equal(3, count(Count(Filter(l, func(value interface{}) bool {
+		return value.(int) > 2
+	}), nil)))
+}
+
+func TestSum(t *testing.T) {
+	a := assert.New(t)
+
+	sum, err := Sum(NewList(1, int64(2), float32(0.5), uint8(3)))
+	a.Nil(err)
+	a.Equal(6.5, sum)
+
+	sum, err = Sum(NewList())
+	a.Nil(err)
+	a.Equal(0.0, sum)
+
+	_, err = Sum(NewList(1, "two"))
+	a.NotNil(err)
+
+	_, err = Sum(NewList(1, nil))
+	a.NotNil(err)
+}
+
+func TestAverage(t *testing.T) {
+	a := assert.New(t)
+
+	average, err := Average(NewList(1, 2, 3, int8(4)))
+	a.Nil(err)
+	a.Equal(2.5, average)
+
+	_, err = Average(NewList())
+	a.Equal(ErrNoElements, err)
+}
+
+func TestMinMax(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(3, 1, 4, 1, 5)
+	min, err := Min(l)
+	a.Nil(err)
+	a.Equal(1, min)
+
+	max, err := Max(l)
+	a.Nil(err)
+	a.Equal(5, max)
+
+	max, err = Max(NewList("foo", "bar", "qux"))
+	a.Nil(err)
+	a.Equal("qux", max)
+
+	_, err = Min(NewList())
+	a.Equal(ErrNoElements, err)
+
+	_, err = Max(NewList(myTestType{Id: 1}, myTestType{Id: 2}))
+	a.NotNil(err)
+
+	max, err = Max(NewList(nil, nil, 1, 3))
+	a.Nil(err)
+	a.Equal(3, max)
+
+	min, err = Min(NewList(nil, nil, 1, 3))
+	a.Nil(err)
+	a.Nil(min)
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(employee{"Sales", 100, "Carol"}, employee{"Sales", 300, "Bob"}, employee{"Sales", 100, "Alice"}, employee{"Sales", 300, "Dave"})
+	salary := func(value interface{}) interface{} {
+		return value.(employee).Salary
+	}
+
+	min, err := MinBy(l, salary)
+	a.Nil(err)
+	a.Equal("Carol", min.(employee).Name)
+
+	max, err := MaxBy(l, salary)
+	a.Nil(err)
+	a.Equal("Bob", max.(employee).Name)
+}
+
+func TestAggregate(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList("foo", "bar", "baz")
+	joined, err := Aggregate(l, ">", func(accumulator, value interface{}) interface{} {
+		return accumulator.(string) + value.(string)
+	})
+	a.Nil(err)
+	a.Equal(">foobarbaz", joined)
+
+	folded, err := Fold(l, func(accumulator, value interface{}) interface{} {
+		return accumulator.(string) + "," + value.(string)
+	})
+	a.Nil(err)
+	a.Equal("foo,bar,baz", folded)
+
+	_, err = Fold(NewList(), func(accumulator, value interface{}) interface{} {
+		return accumulator
+	})
+	a.Equal(ErrNoElements, err)
+}
+
+func TestMinMaxMixedNumericTypes(t *testing.T) {
+	a := assert.New(t)
+
+	max, err := Max(NewList(2, 2.5))
+	a.Nil(err)
+	a.Equal(2.5, max)
+
+	min, err := Min(NewList(int8(1), 300, -200))
+	a.Nil(err)
+	a.Equal(-200, min)
+
+	max, err = Max(NewList(uint(1), -5))
+	a.Nil(err)
+	a.Equal(uint(1), max)
+
+	max, err = Max(NewList(0.5, int64(1<<53+1), float64(1<<53)))
+	a.Nil(err)
+	a.Equal(int64(1<<53+1), max)
+
+	min, err = Min(NewList(int64(1<<53+1), 2.5))
+	a.Nil(err)
+	a.Equal(2.5, min)
+
+	sum, err := Sum(NewList(1, uint64(math.MaxUint64)))
+	a.Nil(err)
+	a.Equal(math.Ldexp(1, 64), sum)
+
+	sum, err = Sum(NewList(int64(1<<53+1), int64(1<<53+1), int64(-1<<54)))
+	a.Nil(err)
+	a.Equal(2.0, sum)
+
+	average, err := Average(NewList(uint64(math.MaxUint64), uint64(math.MaxUint64)))
+	a.Nil(err)
+	a.Equal(math.Ldexp(1, 64), average)
+}
@@ -0,0 +1,122 @@
+package collections
+
+import (
+	"context"
+	"reflect"
+)
+
+// FromChannel returns an Enumerable over the values received from ch, which must be a channel that can be
+// received from; it returns nil for anything else. MoveNext blocks until a value is received or ctx is done,
+// and returns false once ch is closed and drained; if it stopped because ctx was done the enumerator's Err
+// method returns ctx.Err().
+//
+// Values are received only once: enumerators of the result share ch, and Reset does not rewind them.
+func FromChannel(ctx context.Context, ch interface{}) Enumerable {
+	if ch == nil {
+		return nil
+	}
+
+	channelValue := reflect.ValueOf(ch)
+	if channelValue.Kind() != reflect.Chan || channelValue.Type().ChanDir()&reflect.RecvDir == 0 {
+		return nil
+	}
+	return &channelEnumerable{ctx: ctx, channel: channelValue}
+}
+
+// ToChannel returns a channel with the given buffer size that a goroutine fills with the elements of the
+// collection, and closes once they have all been sent or ctx is done. The enumerator of the collection is
+// closed when the goroutine finishes; an error from it ends the stream early, so use ToChannelWithError
+// where it has to be reported.
+//
+// ctx is checked between elements, so the goroutine cannot stop while the collection blocks in MoveNext;
+// a collection that blocks, like one from FromChannel, should be given the same ctx so that it returns.
+func ToChannel(ctx context.Context, collection Enumerable, buffer int) <-chan interface{} {
+	values, _ := ToChannelWithError(ctx, collection, buffer)
+	return values
+}
+
+// ToChannelWithError is ToChannel, and also returns a channel that receives the error from the collection,
+// if there is one, and is closed after the values channel.
+func ToChannelWithError(ctx context.Context, collection Enumerable, buffer int) (<-chan interface{}, <-chan error) {
+	if buffer < 0 {
+		buffer = 0
+	}
+
+	values := make(chan interface{}, buffer)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(values)
+		e := collection.GetEnumerator()
+		defer closeEnumerator(e)
+		for ctx.Err() == nil && e.MoveNext() {
+			select {
+			case values <- e.GetCurrent():
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := enumeratorErr(e); err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
+
+// --------------------------------------------------------------------------------
+// channelEnumerable
+// --------------------------------------------------------------------------------
+
+type channelEnumerable struct {
+	ctx     context.Context
+	channel reflect.Value
+}
+
+func (ce *channelEnumerable) GetEnumerator() Enumerator {
+	return &channelEnumerator{ctx: ce.ctx, channel: ce.channel}
+}
+
+type channelEnumerator struct {
+	ctx     context.Context
+	channel reflect.Value
+	done    bool
+	current interface{}
+	err     error
+}
+
+func (ce *channelEnumerator) MoveNext() bool {
+	if ce.done {
+		return false
+	}
+	if ce.err = ce.ctx.Err(); ce.err != nil {
+		ce.done, ce.current = true, nil
+		return false
+	}
+
+	chosen, value, isOpen := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: ce.channel},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ce.ctx.Done())},
+	})
+	if chosen == 1 {
+		ce.done, ce.current, ce.err = true, nil, ce.ctx.Err()
+		return false
+	}
+	if !isOpen {
+		ce.done, ce.current = true, nil
+		return false
+	}
+	ce.current = value.Interface()
+	return true
+}
+
+func (ce *channelEnumerator) GetCurrent() interface{} {
+	return ce.current
+}
+
+// Err returns the error from ctx if the enumerator stopped because ctx was done.
+func (ce *channelEnumerator) Err() error {
+	return ce.err
+}
+
+// Reset does nothing; values that were received from the channel cannot be received again.
+func (ce *channelEnumerator) Reset() {}
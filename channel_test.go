@@ -0,0 +1,126 @@
+package collections
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/blendlabs/go-exception"
+)
+
+func TestFromChannel(t *testing.T) {
+	a := assert.New(t)
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	e := FromChannel(context.Background(), ch).GetEnumerator()
+	a.Nil(e.GetCurrent())
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+
+	values, err := Collect(FromChannel(context.Background(), ch))
+	a.Nil(err)
+	a.Equal([]interface{}{2, 3}, values.contents)
+
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+}
+
+func TestFromChannelCancel(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int, 1)
+	ch <- 1
+	e := FromChannel(ctx, ch).GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+
+	go cancel()
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+	a.Equal(context.Canceled, enumeratorErr(e))
+
+	ch <- 2
+	a.False(e.MoveNext())
+	a.Equal(2, <-ch)
+}
+
+func TestFromChannelInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(FromChannel(context.Background(), nil))
+	a.Nil(FromChannel(context.Background(), []int{1, 2}))
+	a.Nil(FromChannel(context.Background(), make(chan<- int)))
+}
+
+func TestToChannel(t *testing.T) {
+	a := assert.New(t)
+
+	work := make(chan int)
+	go func() {
+		defer close(work)
+		for value := 0; value < 10; value++ {
+			work <- value
+		}
+	}()
+
+	evens := Filter(FromChannel(context.Background(), work), func(value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+
+	var results []interface{}
+	for value := range ToChannel(context.Background(), evens, 2) {
+		results = append(results, value)
+	}
+	a.Equal([]interface{}{0, 2, 4, 6, 8}, results)
+}
+
+func TestToChannelWithError(t *testing.T) {
+	a := assert.New(t)
+
+	failure := exception.New("read failed")
+	values, errs := ToChannelWithError(context.Background(), &failingEnumerable{values: []interface{}{1, 2}, err: failure}, 0)
+	var results []interface{}
+	for value := range values {
+		results = append(results, value)
+	}
+	a.Equal([]interface{}{1, 2}, results)
+	a.Equal(failure, <-errs)
+
+	values, errs = ToChannelWithError(context.Background(), NewList(1), 1)
+	for range values {
+	}
+	a.Nil(<-errs)
+}
+
+func TestToChannelCancel(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	source := &closingEnumerable{}
+	values := ToChannel(ctx, source, 0)
+
+	a.Equal(0, <-values)
+	a.Equal(1, <-values)
+	cancel()
+
+	for range values {
+	}
+	a.Equal(1, source.closed)
+}
+
+func TestToChannelCancelBlockedSource(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	values := ToChannel(ctx, FromChannel(ctx, make(chan int)), 0)
+	cancel()
+
+	_, isOpen := <-values
+	a.False(isOpen)
+}
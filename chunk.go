@@ -0,0 +1,199 @@
+package collections
+
+// Pair is two consecutive elements of a collection, as yielded by Pairwise.
+type Pair struct {
+	First  interface{}
+	Second interface{}
+}
+
+// Chunk returns a lazy enumerable of *List batches of size elements; the last batch holds whatever
+// is left over. A size less than one is treated as one.
+func Chunk(collection Enumerable, size int) Enumerable {
+	if size < 1 {
+		size = 1
+	}
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &chunkEnumerator{source: source, size: size}
+	}, source: collection}
+}
+
+// Window returns a lazy enumerable of *List windows of size consecutive elements, starting every step
+// elements: a step less than size gives overlapping sliding windows, a step equal to size gives
+// tumbling windows, and a larger step skips elements between windows. Only complete windows are
+// yielded. A size or step less than one is treated as one.
+func Window(collection Enumerable, size, step int) Enumerable {
+	if size < 1 {
+		size = 1
+	}
+	if step < 1 {
+		step = 1
+	}
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &windowEnumerator{source: source, size: size, step: step}
+	}, source: collection}
+}
+
+// Pairwise returns a lazy enumerable of a Pair for each element and the one after it.
+func Pairwise(collection Enumerable) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &pairwiseEnumerator{source: source}
+	}, source: collection}
+}
+
+// --------------------------------------------------------------------------------
+// chunkEnumerator
+// --------------------------------------------------------------------------------
+
+// maxChunkCapacity bounds the capacity a chunk starts with, so a huge size does not allocate up front.
+const maxChunkCapacity = 64
+
+type chunkEnumerator struct {
+	source  Enumerator
+	size    int
+	current *List
+}
+
+func (ce *chunkEnumerator) MoveNext() bool {
+	chunk := &List{contents: make([]interface{}, 0, min(ce.size, maxChunkCapacity))}
+	for chunk.Len() < ce.size && ce.source.MoveNext() {
+		chunk.Add(ce.source.GetCurrent())
+	}
+
+	if chunk.Len() == 0 || (chunk.Len() < ce.size && enumeratorErr(ce.source) != nil) {
+		ce.current = nil
+		return false
+	}
+	ce.current = chunk
+	return true
+}
+
+func (ce *chunkEnumerator) GetCurrent() interface{} {
+	if ce.current == nil {
+		return nil
+	}
+	return ce.current
+}
+
+func (ce *chunkEnumerator) Err() error {
+	return enumeratorErr(ce.source)
+}
+
+func (ce *chunkEnumerator) Close() error {
+	return closeEnumerator(ce.source)
+}
+
+func (ce *chunkEnumerator) Reset() {
+	ce.current = nil
+	ce.source.Reset()
+}
+
+// --------------------------------------------------------------------------------
+// windowEnumerator
+// --------------------------------------------------------------------------------
+
+type windowEnumerator struct {
+	source  Enumerator
+	size    int
+	step    int
+	buffer  []interface{}
+	skip    int
+	current *List
+}
+
+func (we *windowEnumerator) MoveNext() bool {
+	if we.current != nil {
+		if we.step >= len(we.buffer) {
+			we.skip = we.step - len(we.buffer)
+			we.buffer = we.buffer[:0]
+		} else {
+			we.buffer = we.buffer[we.step:]
+		}
+	}
+
+	for len(we.buffer) < we.size {
+		if !we.source.MoveNext() {
+			we.current = nil
+			return false
+		}
+		if we.skip > 0 {
+			we.skip = we.skip - 1
+			continue
+		}
+		we.buffer = append(we.buffer, we.source.GetCurrent())
+	}
+
+	we.current = &List{contents: append([]interface{}{}, we.buffer...)}
+	return true
+}
+
+func (we *windowEnumerator) GetCurrent() interface{} {
+	if we.current == nil {
+		return nil
+	}
+	return we.current
+}
+
+func (we *windowEnumerator) Err() error {
+	return enumeratorErr(we.source)
+}
+
+func (we *windowEnumerator) Close() error {
+	return closeEnumerator(we.source)
+}
+
+func (we *windowEnumerator) Reset() {
+	we.buffer, we.skip, we.current = nil, 0, nil
+	we.source.Reset()
+}
+
+// --------------------------------------------------------------------------------
+// pairwiseEnumerator
+// --------------------------------------------------------------------------------
+
+type pairwiseEnumerator struct {
+	source      Enumerator
+	previous    interface{}
+	hasPrevious bool
+	valid       bool
+	current     Pair
+}
+
+func (pe *pairwiseEnumerator) MoveNext() bool {
+	if !pe.hasPrevious {
+		if !pe.source.MoveNext() {
+			pe.valid = false
+			return false
+		}
+		pe.previous, pe.hasPrevious = pe.source.GetCurrent(), true
+	}
+
+	if !pe.source.MoveNext() {
+		pe.valid = false
+		return false
+	}
+
+	next := pe.source.GetCurrent()
+	pe.current, pe.valid = Pair{First: pe.previous, Second: next}, true
+	pe.previous = next
+	return true
+}
+
+func (pe *pairwiseEnumerator) GetCurrent() interface{} {
+	if !pe.valid {
+		return nil
+	}
+	return pe.current
+}
+
+func (pe *pairwiseEnumerator) Err() error {
+	return enumeratorErr(pe.source)
+}
+
+func (pe *pairwiseEnumerator) Close() error {
+	return closeEnumerator(pe.source)
+}
+
+func (pe *pairwiseEnumerator) Reset() {
+	pe.previous, pe.hasPrevious, pe.valid = nil, false, false
+	pe.source.Reset()
+}
@@ -0,0 +1,63 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func listContents(collection Enumerable) []interface{} {
+	var contents []interface{}
+	for _, value := range contentsOf(collection) {
+		contents = append(contents, value.(*List).contents)
+	}
+	return contents
+}
+
+func TestChunk(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{[]interface{}{1, 2}, []interface{}{3, 4}, []interface{}{5}}, listContents(Chunk(l, 2)))
+	a.Equal([]interface{}{[]interface{}{1, 2, 3, 4, 5}}, listContents(Chunk(l, 5)))
+	a.Empty(listContents(Chunk(NewList(), 5)))
+
+	source := &countingEnumerable{}
+	a.Equal([]interface{}{[]interface{}{0, 1, 2}, []interface{}{3, 4, 5}}, listContents(Take(Chunk(source, 3), 2)))
+	a.Equal(6, source.pulled)
+
+	a.Equal([]interface{}{[]interface{}{1}}, listContents(Chunk(NewList(1), 1<<62)))
+	a.Equal([]interface{}{contentsOf(Range(0, 100, 1))}, listContents(Chunk(Range(0, 100, 1), 1<<62)))
+}
+
+func TestWindow(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{
+		[]interface{}{1, 2, 3},
+		[]interface{}{2, 3, 4},
+		[]interface{}{3, 4, 5},
+	}, listContents(Window(l, 3, 1)))
+	a.Equal([]interface{}{[]interface{}{1, 2}, []interface{}{3, 4}}, listContents(Window(l, 2, 2)))
+	a.Equal([]interface{}{[]interface{}{1, 2}, []interface{}{4, 5}}, listContents(Window(l, 2, 3)))
+	a.Empty(listContents(Window(l, 6, 1)))
+}
+
+func TestWindowMovingAverage(t *testing.T) {
+	a := assert.New(t)
+
+	averages := Map(Window(NewList(2, 4, 6, 8), 2, 1), func(value interface{}) interface{} {
+		average, _ := Average(value.(*List))
+		return average
+	})
+	a.Equal([]interface{}{3.0, 5.0, 7.0}, contentsOf(averages))
+}
+
+func TestPairwise(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{Pair{1, 2}, Pair{2, 3}}, contentsOf(Pairwise(NewList(1, 2, 3))))
+	a.Empty(contentsOf(Pairwise(NewList(1))))
+	a.Equal([]interface{}{Pair{0, 1}, Pair{1, 2}}, contentsOf(Take(Pairwise(&countingEnumerable{}), 2)))
+}
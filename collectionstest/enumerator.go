@@ -0,0 +1,91 @@
+// Package collectionstest implements support for testing implementations of collections.Enumerator.
+package collectionstest
+
+import (
+	"reflect"
+
+	"github.com/blendlabs/go-exception"
+	collections "github.com/wcharczuk/go-collections"
+)
+
+// TestEnumerator checks that an enumerator follows the collections.Enumerator protocol and yields
+// exactly the expected elements, in order, both on the first pass and after a Reset.
+func TestEnumerator(e collections.Enumerator, expected ...interface{}) error {
+	for pass := 0; pass < 2; pass++ {
+		actual, err := drain(e, pass, len(expected))
+		if err != nil {
+			return err
+		}
+		for index := range expected {
+			if !reflect.DeepEqual(expected[index], actual[index]) {
+				return exception.Newf("pass %d: element %d is %#v, expected %#v", pass, index, actual[index], expected[index])
+			}
+		}
+		e.Reset()
+	}
+	return nil
+}
+
+// TestEnumeratorUnordered is TestEnumerator for enumerators that do not define an element order,
+// like those over go maps. It checks that every expected element is yielded exactly once and that
+// a Reset replays the elements in the same order as the first pass.
+func TestEnumeratorUnordered(e collections.Enumerator, expected ...interface{}) error {
+	first, err := drain(e, 0, len(expected))
+	if err != nil {
+		return err
+	}
+
+	remaining := append([]interface{}{}, expected...)
+	for index, value := range first {
+		found := false
+		for remainingIndex, candidate := range remaining {
+			if reflect.DeepEqual(candidate, value) {
+				remaining = append(remaining[:remainingIndex], remaining[remainingIndex+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return exception.Newf("pass 0: element %d is %#v, which was not expected", index, value)
+		}
+	}
+
+	e.Reset()
+	second, err := drain(e, 1, len(expected))
+	if err != nil {
+		return err
+	}
+	for index := range first {
+		if !reflect.DeepEqual(first[index], second[index]) {
+			return exception.Newf("pass 1: element %d is %#v, but was %#v before Reset", index, second[index], first[index])
+		}
+	}
+	return nil
+}
+
+// drain walks a freshly created or Reset enumerator to the end, checking the protocol as it goes.
+func drain(e collections.Enumerator, pass, expectedLength int) ([]interface{}, error) {
+	var actual []interface{}
+	for e.MoveNext() {
+		if len(actual) == expectedLength {
+			return nil, exception.Newf("pass %d: MoveNext returned true after %d elements, expected %d", pass, len(actual), expectedLength)
+		}
+
+		current := e.GetCurrent()
+		if again := e.GetCurrent(); !reflect.DeepEqual(current, again) {
+			return nil, exception.Newf("pass %d: GetCurrent for element %d changed from %#v to %#v without MoveNext", pass, len(actual), current, again)
+		}
+		actual = append(actual, current)
+	}
+
+	if len(actual) != expectedLength {
+		return nil, exception.Newf("pass %d: MoveNext returned false after %d elements, expected %d", pass, len(actual), expectedLength)
+	}
+	if e.MoveNext() {
+		return nil, exception.Newf("pass %d: MoveNext returned true after returning false", pass)
+	}
+	if current := e.GetCurrent(); current != nil {
+		return nil, exception.Newf("pass %d: GetCurrent returned %#v after the last element, expected nil", pass, current)
+	}
+	return actual, nil
+}
@@ -0,0 +1,79 @@
+package collectionstest
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	collections "github.com/wcharczuk/go-collections"
+)
+
+// startsOnFirstEnumerator is positioned on its first element before MoveNext is called.
+type startsOnFirstEnumerator struct {
+	index    int
+	contents []interface{}
+}
+
+func (se *startsOnFirstEnumerator) MoveNext() bool {
+	se.index = se.index + 1
+	return se.index < len(se.contents)
+}
+
+func (se *startsOnFirstEnumerator) GetCurrent() interface{} {
+	if se.index < len(se.contents) {
+		return se.contents[se.index]
+	}
+	return nil
+}
+
+func (se *startsOnFirstEnumerator) Reset() {
+	se.index = 0
+}
+
+// noResetEnumerator ignores Reset.
+type noResetEnumerator struct {
+	index    int
+	contents []interface{}
+}
+
+func (ne *noResetEnumerator) MoveNext() bool {
+	if ne.index+1 >= len(ne.contents) {
+		ne.index = len(ne.contents)
+		return false
+	}
+	ne.index = ne.index + 1
+	return true
+}
+
+func (ne *noResetEnumerator) GetCurrent() interface{} {
+	if ne.index >= 0 && ne.index < len(ne.contents) {
+		return ne.contents[ne.index]
+	}
+	return nil
+}
+
+func (ne *noResetEnumerator) Reset() {}
+
+func TestTestEnumerator(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(TestEnumerator(collections.NewSliceEnumerator([]int{1, 2, 3}), 1, 2, 3))
+	a.Nil(TestEnumerator(collections.NewSliceEnumerator([]int{})))
+	a.NotNil(TestEnumerator(collections.NewSliceEnumerator([]int{1, 2, 3}), 1, 2))
+	a.NotNil(TestEnumerator(collections.NewSliceEnumerator([]int{1, 2, 3}), 1, 2, 3, 4))
+	a.NotNil(TestEnumerator(collections.NewSliceEnumerator([]int{1, 2, 3}), 1, 3, 2))
+}
+
+func TestTestEnumeratorCatchesProtocolViolations(t *testing.T) {
+	a := assert.New(t)
+
+	a.NotNil(TestEnumerator(&startsOnFirstEnumerator{contents: []interface{}{1, 2, 3}}, 1, 2, 3))
+	a.NotNil(TestEnumerator(&noResetEnumerator{index: -1, contents: []interface{}{1, 2, 3}}, 1, 2, 3))
+}
+
+func TestTestEnumeratorUnordered(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(TestEnumeratorUnordered(collections.NewSliceEnumerator([]int{3, 1, 2}), 1, 2, 3))
+	a.NotNil(TestEnumeratorUnordered(collections.NewSliceEnumerator([]int{3, 1, 1}), 1, 2, 3))
+	a.NotNil(TestEnumeratorUnordered(collections.NewSliceEnumerator([]int{3, 1}), 1, 2, 3))
+}
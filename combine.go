@@ -0,0 +1,223 @@
+package collections
+
+// ZipResultSelector projects an element of each of two collections into a result.
+type ZipResultSelector func(first, second interface{}) interface{}
+
+// Concat returns a lazy enumerable of the elements of each collection in turn.
+func Concat(collections ...Enumerable) Enumerable {
+	return &concatEnumerable{sources: collections}
+}
+
+// Prepend returns a lazy enumerable of the values followed by the elements of the collection.
+func Prepend(collection Enumerable, values ...interface{}) Enumerable {
+	return Concat(&List{contents: values}, collection)
+}
+
+// Append returns a lazy enumerable of the elements of the collection followed by the values.
+func Append(collection Enumerable, values ...interface{}) Enumerable {
+	return Concat(collection, &List{contents: values})
+}
+
+// Zip returns a lazy enumerable of resultSelector applied to the elements of first and second at the
+// same position; it stops at the end of the shorter collection.
+func Zip(first, second Enumerable, resultSelector ZipResultSelector) Enumerable {
+	return &zipEnumerable{first: first, second: second, resultSelector: resultSelector}
+}
+
+// ZipLongest is Zip, except that it continues to the end of the longer collection, using firstFill or
+// secondFill in place of the elements of the shorter one.
+func ZipLongest(first, second Enumerable, firstFill, secondFill interface{}, resultSelector ZipResultSelector) Enumerable {
+	return &zipEnumerable{
+		first:          first,
+		second:         second,
+		resultSelector: resultSelector,
+		longest:        true,
+		firstFill:      firstFill,
+		secondFill:     secondFill,
+	}
+}
+
+// SelectMany returns a lazy enumerable of the elements of the collections or slices returned by selector
+// for each element of the collection, in turn. Values that are neither are yielded as they are.
+func SelectMany(collection Enumerable, selector MapAction) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &selectManyEnumerator{source: source, selector: selector}
+	}, source: collection}
+}
+
+// Flatten returns a lazy enumerable of the elements of each collection or slice in the collection.
+func Flatten(collection Enumerable) Enumerable {
+	return SelectMany(collection, DefaultKeySelector)
+}
+
+// --------------------------------------------------------------------------------
+// zipEnumerable
+// --------------------------------------------------------------------------------
+
+type zipEnumerable struct {
+	first          Enumerable
+	second         Enumerable
+	resultSelector ZipResultSelector
+	longest        bool
+	firstFill      interface{}
+	secondFill     interface{}
+}
+
+func (ze *zipEnumerable) GetEnumerator() Enumerator {
+	return &zipEnumerator{
+		parent: ze,
+		first:  ze.first.GetEnumerator(),
+		second: ze.second.GetEnumerator(),
+	}
+}
+
+type zipEnumerator struct {
+	parent  *zipEnumerable
+	first   Enumerator
+	second  Enumerator
+	valid   bool
+	current interface{}
+	err     error
+}
+
+func (ze *zipEnumerator) MoveNext() bool {
+	if ze.err != nil {
+		return false
+	}
+
+	hasFirst := ze.first.MoveNext()
+	if !hasFirst {
+		ze.err = enumeratorErr(ze.first)
+	}
+	// a shortest zip does not read second once first has ended, so a source like a channel loses nothing
+	hasSecond := false
+	if hasFirst || (ze.parent.longest && ze.err == nil) {
+		hasSecond = ze.second.MoveNext()
+		if !hasSecond {
+			ze.err = enumeratorErr(ze.second)
+		}
+	}
+
+	if ze.err != nil {
+		ze.valid = false
+	} else if ze.parent.longest {
+		ze.valid = hasFirst || hasSecond
+	} else {
+		ze.valid = hasFirst && hasSecond
+	}
+	if !ze.valid {
+		ze.current = nil
+		return false
+	}
+
+	firstValue, secondValue := ze.parent.firstFill, ze.parent.secondFill
+	if hasFirst {
+		firstValue = ze.first.GetCurrent()
+	}
+	if hasSecond {
+		secondValue = ze.second.GetCurrent()
+	}
+	ze.current = ze.parent.resultSelector(firstValue, secondValue)
+	return true
+}
+
+func (ze *zipEnumerator) GetCurrent() interface{} {
+	return ze.current
+}
+
+func (ze *zipEnumerator) Err() error {
+	return ze.err
+}
+
+func (ze *zipEnumerator) Close() error {
+	firstErr := closeEnumerator(ze.first)
+	if secondErr := closeEnumerator(ze.second); firstErr == nil {
+		return secondErr
+	}
+	return firstErr
+}
+
+func (ze *zipEnumerator) Reset() {
+	ze.valid, ze.current, ze.err = false, nil, nil
+	ze.first.Reset()
+	ze.second.Reset()
+}
+
+// --------------------------------------------------------------------------------
+// selectManyEnumerator
+// --------------------------------------------------------------------------------
+
+type selectManyEnumerator struct {
+	source   Enumerator
+	selector MapAction
+	inner    Enumerator
+	err      error
+}
+
+func (se *selectManyEnumerator) MoveNext() bool {
+	if se.err != nil {
+		return false
+	}
+	for {
+		if se.inner != nil {
+			if se.inner.MoveNext() {
+				return true
+			}
+			se.err = enumeratorErr(se.inner)
+			if closeErr := closeEnumerator(se.inner); se.err == nil {
+				se.err = closeErr
+			}
+			if se.err != nil {
+				se.inner = nil
+				return false
+			}
+		}
+		if !se.source.MoveNext() {
+			se.err = enumeratorErr(se.source)
+			se.inner = nil
+			return false
+		}
+		se.inner = enumeratorFor(se.selector(se.source.GetCurrent()))
+	}
+}
+
+func (se *selectManyEnumerator) GetCurrent() interface{} {
+	if se.inner == nil {
+		return nil
+	}
+	return se.inner.GetCurrent()
+}
+
+func (se *selectManyEnumerator) Err() error {
+	return se.err
+}
+
+func (se *selectManyEnumerator) Close() error {
+	var innerErr error
+	if se.inner != nil {
+		innerErr = closeEnumerator(se.inner)
+	}
+	if sourceErr := closeEnumerator(se.source); innerErr == nil {
+		return sourceErr
+	}
+	return innerErr
+}
+
+func (se *selectManyEnumerator) Reset() {
+	if se.inner != nil {
+		closeEnumerator(se.inner)
+	}
+	se.inner, se.err = nil, nil
+	se.source.Reset()
+}
+
+// enumeratorFor returns an enumerator over an Enumerable or a slice, or over just the value itself otherwise.
+func enumeratorFor(value interface{}) Enumerator {
+	if typedValue, isEnumerable := value.(Enumerable); isEnumerable {
+		return typedValue.GetEnumerator()
+	}
+	if isSlice(value) {
+		return NewSliceEnumerator(value)
+	}
+	return NewSliceEnumerator([]interface{}{value})
+}
@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestConcat(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(Concat(NewList(1, 2), NewList(), NewList(3), NewList(4, 5))))
+	a.Empty(contentsOf(Concat()))
+	a.Equal([]interface{}{1, 0, 1}, contentsOf(Take(Concat(NewList(1), &countingEnumerable{}), 3)))
+}
+
+func TestPrependAppend(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{0, 1, 2}, contentsOf(Prepend(NewList(1, 2), 0)))
+	a.Equal([]interface{}{1, 2, 3, 4}, contentsOf(Append(NewList(1, 2), 3, 4)))
+	a.Equal([]interface{}{[]int{0}, 1}, contentsOf(Prepend(NewList(1), []int{0})))
+}
+
+func TestZip(t *testing.T) {
+	a := assert.New(t)
+
+	pair := func(first, second interface{}) interface{} {
+		return Pair{First: first, Second: second}
+	}
+
+	a.Equal([]interface{}{Pair{1, "a"}, Pair{2, "b"}}, contentsOf(Zip(NewList(1, 2, 3), NewList("a", "b"), pair)))
+	a.Equal([]interface{}{Pair{0, "a"}, Pair{1, "b"}}, contentsOf(Zip(&countingEnumerable{}, NewList("a", "b"), pair)))
+	a.Equal([]interface{}{Pair{1, "a"}, Pair{2, "b"}, Pair{3, "-"}}, contentsOf(ZipLongest(NewList(1, 2, 3), NewList("a", "b"), 0, "-", pair)))
+	a.Equal([]interface{}{Pair{1, "a"}, Pair{0, "b"}}, contentsOf(ZipLongest(NewList(1), NewList("a", "b"), 0, "-", pair)))
+
+	second := &countingEnumerable{}
+	a.Equal([]interface{}{Pair{1, 0}, Pair{2, 1}}, contentsOf(Zip(NewList(1, 2), second, pair)))
+	a.Equal(2, second.pulled)
+}
+
+func TestSelectMany(t *testing.T) {
+	a := assert.New(t)
+
+	words := SelectMany(NewList("foo bar", "", "baz"), func(value interface{}) interface{} {
+		return strings.Fields(value.(string))
+	})
+	a.Equal([]interface{}{"foo", "bar", "baz"}, contentsOf(words))
+
+	repeated := SelectMany(NewList(1, 2, 3), func(value interface{}) interface{} {
+		return Take(&countingEnumerable{}, value.(int))
+	})
+	a.Equal([]interface{}{0, 0, 1, 0, 1, 2}, contentsOf(repeated))
+}
+
+func TestFlatten(t *testing.T) {
+	a := assert.New(t)
+
+	nested := &List{contents: []interface{}{[]int{1, 2}, NewList(3, 4), 5, []string{}}}
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(Flatten(nested)))
+}
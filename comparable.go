@@ -1,6 +1,8 @@
 package collections
 
 import (
+	"cmp"
+	"math"
 	"reflect"
 	"strings"
 
@@ -24,251 +26,132 @@ type Comparer func(this, that interface{}) (int, error)
 // we need this for sorting
 // --------------------------------------------------------------------------------
 
+var comparableType = reflect.TypeOf((*Comparable)(nil)).Elem()
+
 func getComparer(forType reflect.Type) (Comparer, error) {
-	switch forType.Kind() {
-	case reflect.Uint8:
-		return uint8Comparer, nil
-	case reflect.Uint16:
-		return uint16Comparer, nil
-	case reflect.Uint32:
-		return uint32Comparer, nil
-	case reflect.Uint64:
-		return uint64Comparer, nil
-	case reflect.Int8:
-		return int8Comparer, nil
-	case reflect.Int16:
-		return int16Comparer, nil
-	case reflect.Int32, reflect.Int:
-		return intComparer, nil
-	case reflect.Int64:
-		return int64Comparer, nil
-	case reflect.Float32:
-		return float32Comparer, nil
-	case reflect.Float64:
-		return float64Comparer, nil
-	default:
-		if typed, isComparable := forType.(Comparable); isComparable {
-			return wrapComparable(typed), nil
-		} else {
-			return nil, exception.Newf("%v does not implement Comparable and is not a builtin type.", forType.Name())
-		}
+	if forType.Implements(comparableType) {
+		return comparableComparer, nil
 	}
 
-	return nil, nil
+	switch {
+	case isNumericKind(forType.Kind()):
+		return numericComparer, nil
+	case forType.Kind() == reflect.String:
+		return stringComparer, nil
+	default:
+		return nil, exception.Newf("%v does not implement Comparable and is not a builtin type.", forType)
+	}
 }
 
-// --------------------------------------------------------------------------------
-// Comparable helpers
-// --------------------------------------------------------------------------------
-
-func wrapComparable(source Comparable) Comparer {
-	return func(this, that interface{}) (int, error) {
-		return source.CompareTo(that)
+// getComparerForValues resolves the comparer from the type of the first non-nil value.
+func getComparerForValues(values []interface{}) (Comparer, error) {
+	for _, value := range values {
+		if value != nil {
+			return getComparer(reflect.TypeOf(value))
+		}
 	}
+	return nilComparer, nil
 }
 
 // --------------------------------------------------------------------------------
-// comparers for builtins
+// Comparable helpers
 // --------------------------------------------------------------------------------
 
-func uint8Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsUInt8(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsUInt8(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
-	return 0, nil
-}
-
-func uint16Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsUInt16(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsUInt16(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
-	return 0, nil
-}
-
-func uint32Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsUInt32(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsUInt32(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
+func comparableComparer(this, that interface{}) (int, error) {
+	thisTyped, isComparable := this.(Comparable)
+	if !isComparable {
+		return 0, exception.Newf("%v does not implement Comparable", reflect.TypeOf(this))
 	}
-
-	return 0, nil
+	return thisTyped.CompareTo(that)
 }
 
-func uint64Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsUInt64(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsUInt64(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
+// nilComparer is used when every value is nil, and so every value is equal.
+func nilComparer(this, that interface{}) (int, error) {
 	return 0, nil
 }
 
-func int8Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsInt8(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsInt8(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
+// compareNilFirst orders nil before any other value, and otherwise defers to the comparer.
+func compareNilFirst(comparer Comparer, this, that interface{}) (int, error) {
+	if this == nil && that == nil {
+		return 0, nil
+	} else if this == nil {
 		return -1, nil
-	} else if thisTyped > thatTyped {
+	} else if that == nil {
 		return 1, nil
 	}
-
-	return 0, nil
-}
-
-func int16Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsInt16(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsInt16(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
-	return 0, nil
+	return comparer(this, that)
 }
 
-func intComparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsInt(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsInt(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
-	return 0, nil
-}
-
-func int64Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsInt64(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsInt64(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
-
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
-	}
-
-	return 0, nil
-}
-
-func float32Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsFloat32(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsFloat32(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
-	}
+// --------------------------------------------------------------------------------
+// comparers for builtins
+// --------------------------------------------------------------------------------
 
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
+// numericComparer compares numbers of any mix of numeric types exactly: integers are compared as integers,
+// with negative signed values less than any unsigned value, and an integer is compared with a float by its
+// whole and fractional parts, so no value is rounded.
+func numericComparer(this, that interface{}) (int, error) {
+	thisValue, thatValue := reflect.ValueOf(this), reflect.ValueOf(that)
+	if this == nil || that == nil || !isNumericKind(thisValue.Kind()) || !isNumericKind(thatValue.Kind()) {
+		return 0, exception.Newf("Cannot compare %v with %v", reflect.TypeOf(this), reflect.TypeOf(that))
+	}
+
+	thisFloat, thatFloat := isFloatKind(thisValue.Kind()), isFloatKind(thatValue.Kind())
+	switch {
+	case thisFloat && thatFloat:
+		return compareOrdered(thisValue.Float(), thatValue.Float()), nil
+	case thisFloat:
+		return -compareIntegerWithFloat(thatValue, thisValue.Float()), nil
+	case thatFloat:
+		return compareIntegerWithFloat(thisValue, thatValue.Float()), nil
+	}
+
+	thisSigned, thatSigned := isSignedKind(thisValue.Kind()), isSignedKind(thatValue.Kind())
+	switch {
+	case thisSigned && thatSigned:
+		return compareOrdered(thisValue.Int(), thatValue.Int()), nil
+	case !thisSigned && !thatSigned:
+		return compareOrdered(thisValue.Uint(), thatValue.Uint()), nil
+	case thisSigned:
+		if thisValue.Int() < 0 {
+			return -1, nil
+		}
+		return compareOrdered(uint64(thisValue.Int()), thatValue.Uint()), nil
+	default:
+		if thatValue.Int() < 0 {
+			return 1, nil
+		}
+		return compareOrdered(thisValue.Uint(), uint64(thatValue.Int())), nil
 	}
-
-	return 0, nil
 }
 
-func float64Comparer(this, that interface{}) (int, error) {
-	thisTyped, thisTypedErr := castAsFloat64(this)
-	if thisTypedErr != nil {
-		return 0, thisTypedErr
-	}
-
-	thatTyped, thatTypedErr := castAsFloat64(that)
-	if thatTypedErr != nil {
-		return 0, thatTypedErr
+// compareIntegerWithFloat compares an integer with a float without rounding either: first with the whole part
+// of the float, and then, if they are equal, with its fraction. Like compareOrdered it treats NaN as equal.
+func compareIntegerWithFloat(integer reflect.Value, float float64) int {
+	if math.IsNaN(float) {
+		return 0
 	}
 
-	if thisTyped < thatTyped {
-		return -1, nil
-	} else if thisTyped > thatTyped {
-		return 1, nil
+	whole := math.Trunc(float)
+	if isSignedKind(integer.Kind()) {
+		if whole >= math.Ldexp(1, 63) {
+			return -1
+		} else if whole < -math.Ldexp(1, 63) {
+			return 1
+		}
+		if compareResult := compareOrdered(integer.Int(), int64(whole)); compareResult != 0 {
+			return compareResult
+		}
+	} else {
+		if whole >= math.Ldexp(1, 64) {
+			return -1
+		} else if whole < 0 {
+			return 1
+		}
+		if compareResult := compareOrdered(integer.Uint(), uint64(whole)); compareResult != 0 {
+			return compareResult
+		}
 	}
-
-	return 0, nil
+	return compareOrdered(whole, float)
 }
 
 func stringComparer(this, that interface{}) (int, error) {
@@ -285,171 +168,92 @@ func stringComparer(this, that interface{}) (int, error) {
 	return strings.Compare(thisTyped, thatTyped), nil
 }
 
-func castAsUInt8(value interface{}) (uint8, error) {
-	if typedValue, isTyped := value.(uint8); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(int8(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(uint8), nil
-		}
+func compareOrdered[T cmp.Ordered](this, that T) int {
+	if this < that {
+		return -1
+	} else if this > that {
+		return 1
 	}
-
-	valueType := reflect.TypeOf(value)
-	return uint8(0), exception.New("Cannot cast %v as uint8", valueType.Name())
+	return 0
 }
 
-func castAsUInt16(value interface{}) (uint16, error) {
-	if typedValue, isTyped := value.(uint16); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(uint16(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(uint16), nil
-		}
+// castAsNumeric converts a numeric value to the numeric type toType, and returns an error rather than
+// a different number if toType cannot represent the value exactly.
+func castAsNumeric(value interface{}, toType reflect.Type) (reflect.Value, error) {
+	if value == nil || !isNumericKind(reflect.TypeOf(value).Kind()) || !isNumericKind(toType.Kind()) {
+		return reflect.Value{}, exception.Newf("Cannot cast %v as %v", reflect.TypeOf(value), toType)
 	}
 
-	valueType := reflect.TypeOf(value)
-	return uint16(0), exception.New("Cannot cast %v as uint16", valueType.Name())
-}
-
-func castAsUInt32(value interface{}) (uint32, error) {
-	if typedValue, isTyped := value.(uint32); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(uint16(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(uint32), nil
+	valueValue := reflect.ValueOf(value)
+	if isFloatKind(valueValue.Kind()) && !isFloatKind(toType.Kind()) {
+		if !floatFitsInteger(valueValue.Float(), toType) {
+			return reflect.Value{}, exception.Newf("Cannot cast %v as %v without losing precision", value, toType)
 		}
+		return valueValue.Convert(toType), nil
 	}
 
-	valueType := reflect.TypeOf(value)
-	return uint32(0), exception.New("Cannot cast %v as uint16", valueType.Name())
-}
-
-func castAsUInt64(value interface{}) (uint64, error) {
-	if typedValue, isTyped := value.(uint64); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(uint64(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(uint64), nil
-		}
+	converted := valueValue.Convert(toType)
+	if isFloatKind(valueValue.Kind()) && math.IsNaN(valueValue.Float()) {
+		return converted, nil
 	}
-
-	valueType := reflect.TypeOf(value)
-	return uint64(0), exception.New("Cannot cast %v as uint64", valueType.Name())
-}
-
-func castAsInt8(value interface{}) (int8, error) {
-	if typedValue, isTyped := value.(int8); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(int8(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(int8), nil
-		}
+	if !isFloatKind(valueValue.Kind()) && isFloatKind(toType.Kind()) && !floatFitsInteger(converted.Float(), valueValue.Type()) {
+		return reflect.Value{}, exception.Newf("Cannot cast %v as %v without losing precision", value, toType)
 	}
-
-	valueType := reflect.TypeOf(value)
-	return int8(0), exception.New("Cannot cast %v as int8", valueType.Name())
+	if converted.Convert(valueValue.Type()).Interface() != value || isNegative(converted) != isNegative(valueValue) {
+		return reflect.Value{}, exception.Newf("Cannot cast %v as %v without losing precision", value, toType)
+	}
+	return converted, nil
 }
 
-func castAsInt16(value interface{}) (int16, error) {
-	if typedValue, isTyped := value.(int16); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(int16(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(int16), nil
-		}
+// floatFitsInteger returns whether value is a whole number in the range of the integer type toType.
+func floatFitsInteger(value float64, toType reflect.Type) bool {
+	if value != math.Trunc(value) || math.IsInf(value, 0) {
+		return false
 	}
 
-	valueType := reflect.TypeOf(value)
-	return int16(0), exception.New("Cannot cast %v as int16", valueType.Name())
+	bits := toType.Bits()
+	if isSignedKind(toType.Kind()) {
+		limit := math.Ldexp(1, bits-1)
+		return value >= -limit && value < limit
+	}
+	return value >= 0 && value < math.Ldexp(1, bits)
 }
 
-func castAsInt(value interface{}) (int, error) {
-	if typedValue, isTyped := value.(int); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(int(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(int), nil
-		}
+func isNegative(value reflect.Value) bool {
+	switch {
+	case isSignedKind(value.Kind()):
+		return value.Int() < 0
+	case isFloatKind(value.Kind()):
+		return value.Float() < 0
 	}
-
-	valueType := reflect.TypeOf(value)
-	return int(0), exception.New("Cannot cast %v as int", valueType.Name())
+	return false
 }
 
-func castAsInt64(value interface{}) (int64, error) {
-	if typedValue, isTyped := value.(int64); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(int64(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(int64), nil
-		}
+func castAsString(value interface{}) (string, error) {
+	if valueAsString, isString := value.(string); isString {
+		return valueAsString, nil
 	}
-
-	valueType := reflect.TypeOf(value)
-	return int64(0), exception.New("Cannot cast %v as int64", valueType.Name())
+	return "", exception.Newf("Cannot cast %v as string", reflect.TypeOf(value))
 }
 
-func castAsFloat32(value interface{}) (float32, error) {
-	if typedValue, isTyped := value.(float32); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(float32(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(float32), nil
-		}
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
 	}
-
-	valueType := reflect.TypeOf(value)
-	return float32(0), exception.New("Cannot cast %v as float32", valueType.Name())
+	return false
 }
 
-func castAsFloat64(value interface{}) (float64, error) {
-	if typedValue, isTyped := value.(float64); isTyped {
-		return typedValue, nil
-	} else {
-		destinationType := reflect.TypeOf(float64(0))
-		valueType := reflect.TypeOf(value)
-		valueReflected := reflect.ValueOf(value)
-		if valueType.ConvertibleTo(destinationType) {
-			return valueReflected.Convert(destinationType).Interface().(float64), nil
-		}
+func isSignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
 	}
-
-	valueType := reflect.TypeOf(value)
-	return float64(0), exception.New("Cannot cast %v as float64", valueType.Name())
+	return false
 }
 
-func castAsString(value interface{}) (string, error) {
-	if valueAsString, isString := value.(string); isString {
-		return valueAsString, nil
-	} else {
-		valueType := reflect.TypeOf(value)
-		return "", exception.New("Cannot cast %v as string", valueType.Name())
-	}
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
 }
@@ -0,0 +1,94 @@
+package collections
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestCastAs(t *testing.T) {
+	a := assert.New(t)
+
+	asUInt8, err := castAsNumeric(7, reflect.TypeOf(uint8(0)))
+	a.Nil(err)
+	a.Equal(uint8(7), asUInt8.Interface())
+
+	asUInt32, err := castAsNumeric(int64(7), reflect.TypeOf(uint32(0)))
+	a.Nil(err)
+	a.Equal(uint32(7), asUInt32.Interface())
+
+	asInt, err := castAsNumeric(2.0, reflect.TypeOf(0))
+	a.Nil(err)
+	a.Equal(2, asInt.Interface())
+
+	asFloat64, err := castAsNumeric(float32(0.5), reflect.TypeOf(0.0))
+	a.Nil(err)
+	a.Equal(0.5, asFloat64.Interface())
+
+	_, err = castAsNumeric(nil, reflect.TypeOf(0))
+	a.NotNil(err)
+
+	_, err = castAsNumeric("7", reflect.TypeOf(0))
+	a.NotNil(err)
+
+	_, err = castAsString(7)
+	a.NotNil(err)
+}
+
+func TestCastAsLosingPrecision(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := castAsNumeric(300, reflect.TypeOf(uint8(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(-1, reflect.TypeOf(uint(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(uint64(math.MaxUint64), reflect.TypeOf(int64(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(2.5, reflect.TypeOf(0))
+	a.NotNil(err)
+	_, err = castAsNumeric(1e20, reflect.TypeOf(int64(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(-1.0, reflect.TypeOf(uint8(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(0.1, reflect.TypeOf(float32(0)))
+	a.NotNil(err)
+	_, err = castAsNumeric(int64(1<<53+1), reflect.TypeOf(0.0))
+	a.NotNil(err)
+	_, err = castAsNumeric(int64(math.MaxInt64), reflect.TypeOf(0.0))
+	a.NotNil(err)
+}
+
+func TestNumericComparer(t *testing.T) {
+	a := assert.New(t)
+
+	compare := func(this, that interface{}) int {
+		result, err := numericComparer(this, that)
+		a.Nil(err)
+		return result
+	}
+
+	a.Equal(-1, compare(2, 2.5))
+	a.Equal(1, compare(int8(1), -200))
+	a.Equal(-1, compare(int8(1), 300))
+	a.Equal(1, compare(uint(1), -5))
+	a.Equal(-1, compare(-5, uint(1)))
+	a.Equal(1, compare(uint64(math.MaxUint64), int64(math.MaxInt64)))
+	a.Equal(0, compare(float32(0.5), 0.5))
+	a.Equal(0, compare(uint8(3), int64(3)))
+
+	a.Equal(1, compare(int64(1<<53+1), 0.5))
+	a.Equal(1, compare(int64(1<<53+1), float64(1<<53)))
+	a.Equal(-1, compare(float64(1<<53), int64(1<<53+1)))
+	a.Equal(-1, compare(-1, -0.5))
+	a.Equal(1, compare(-1, -1.5))
+	a.Equal(1, compare(uint(0), -0.5))
+	a.Equal(-1, compare(uint64(math.MaxUint64), math.Ldexp(1, 64)))
+	a.Equal(1, compare(int64(math.MinInt64), math.Inf(-1)))
+	a.Equal(-1, compare(int64(math.MaxInt64), math.Ldexp(1, 63)))
+	a.Equal(0, compare(3, 3.0))
+
+	_, err := numericComparer(1, "1")
+	a.NotNil(err)
+}
@@ -0,0 +1,94 @@
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	collections "github.com/wcharczuk/go-collections"
+	"github.com/wcharczuk/go-collections/collectionstest"
+)
+
+func TestEnumeratorConformance(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(collectionstest.TestEnumerator(collections.NewSliceEnumerator([]int{1, 2, 3}), 1, 2, 3))
+	a.Nil(collectionstest.TestEnumerator(collections.NewSliceEnumerator([]string{})))
+	a.Nil(collectionstest.TestEnumerator(collections.NewList(1, 2, 3).GetEnumerator(), 1, 2, 3))
+	a.Nil(collectionstest.TestEnumerator(collections.NewList().GetEnumerator()))
+
+	myMap := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+	a.Nil(collectionstest.TestEnumeratorUnordered(collections.NewMapEnumerator(myMap),
+		collections.KeyValuePair{Key: "foo", Value: 1}, collections.KeyValuePair{Key: "bar", Value: 2}, collections.KeyValuePair{Key: "baz", Value: 3}))
+	a.Nil(collectionstest.TestEnumerator(collections.NewSortedMapEnumerator(myMap, nil),
+		collections.KeyValuePair{Key: "bar", Value: 2}, collections.KeyValuePair{Key: "baz", Value: 3}, collections.KeyValuePair{Key: "foo", Value: 1}))
+	a.Nil(collectionstest.TestEnumeratorUnordered(collections.NewMapEnumerator(map[string]int{})))
+
+	a.Nil(collectionstest.TestEnumerator(collections.FromSeq(slices.Values([]int{1, 2, 3})).GetEnumerator(), 1, 2, 3))
+	a.Nil(collectionstest.TestEnumerator(collections.FromSeq2(slices.All([]int{1, 2, 3})).GetEnumerator(),
+		collections.KeyValuePair{Key: 0, Value: 1}, collections.KeyValuePair{Key: 1, Value: 2}, collections.KeyValuePair{Key: 2, Value: 3}))
+
+	a.Nil(collectionstest.TestEnumerator(collections.Range(1, 3, 1).GetEnumerator(), 1, 2, 3))
+	a.Nil(collectionstest.TestEnumerator(collections.Repeat("a", 2).GetEnumerator(), "a", "a"))
+	a.Nil(collectionstest.TestEnumerator(collections.Empty().GetEnumerator()))
+
+	double := func(value interface{}) interface{} {
+		return value.(int) * 2
+	}
+	overTen := func(value interface{}) bool {
+		return value.(int) > 10
+	}
+	a.Nil(collectionstest.TestEnumerator(collections.Generate(1, double, overTen).GetEnumerator(), 1, 2, 4, 8))
+}
+
+func TestLinqEnumeratorConformance(t *testing.T) {
+	a := assert.New(t)
+
+	l := collections.NewList(1, 2, 3, 4)
+	doubled := collections.Map(l, func(value interface{}) interface{} {
+		return value.(int) * 2
+	})
+	a.Nil(collectionstest.TestEnumerator(doubled.GetEnumerator(), 2, 4, 6, 8))
+
+	even := collections.Filter(l, func(value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	a.Nil(collectionstest.TestEnumerator(even.GetEnumerator(), 2, 4))
+
+	none := collections.Filter(l, func(value interface{}) bool {
+		return false
+	})
+	a.Nil(collectionstest.TestEnumerator(none.GetEnumerator()))
+
+	distinct := collections.Distinct(collections.NewList(1, 2, 1, 3, 2), nil)
+	a.Nil(collectionstest.TestEnumerator(distinct.GetEnumerator(), 1, 2, 3))
+
+	union := collections.Union(collections.NewList(1, 2), collections.NewList(2, 3), nil)
+	a.Nil(collectionstest.TestEnumerator(union.GetEnumerator(), 1, 2, 3))
+
+	l = collections.NewList(1, 2, 3, 4, 5)
+	a.Nil(collectionstest.TestEnumerator(collections.Take(l, 2).GetEnumerator(), 1, 2))
+	a.Nil(collectionstest.TestEnumerator(collections.Skip(l, 3).GetEnumerator(), 4, 5))
+	a.Nil(collectionstest.TestEnumerator(collections.TakeLast(l, 2).GetEnumerator(), 4, 5))
+	a.Nil(collectionstest.TestEnumerator(collections.SkipLast(l, 2).GetEnumerator(), 1, 2, 3))
+
+	lessThanThree := func(value interface{}) bool {
+		return value.(int) < 3
+	}
+	a.Nil(collectionstest.TestEnumerator(collections.TakeWhile(l, lessThanThree).GetEnumerator(), 1, 2))
+	a.Nil(collectionstest.TestEnumerator(collections.SkipWhile(l, lessThanThree).GetEnumerator(), 3, 4, 5))
+
+	a.Nil(collectionstest.TestEnumerator(collections.Pairwise(l).GetEnumerator(),
+		collections.Pair{First: 1, Second: 2}, collections.Pair{First: 2, Second: 3},
+		collections.Pair{First: 3, Second: 4}, collections.Pair{First: 4, Second: 5}))
+	a.Nil(collectionstest.TestEnumerator(collections.Chunk(l, 3).GetEnumerator(), collections.NewList(1, 2, 3), collections.NewList(4, 5)))
+	a.Nil(collectionstest.TestEnumerator(collections.Window(l, 4, 1).GetEnumerator(), collections.NewList(1, 2, 3, 4), collections.NewList(2, 3, 4, 5)))
+
+	a.Nil(collectionstest.TestEnumerator(collections.Concat(collections.NewList(1), collections.NewList(), collections.NewList(2)).GetEnumerator(), 1, 2))
+	a.Nil(collectionstest.TestEnumerator(collections.Flatten(collections.NewList([]int{1, 2}, []int{3})).GetEnumerator(), 1, 2, 3))
+
+	sum := func(first, second interface{}) interface{} {
+		return first.(int) + second.(int)
+	}
+	a.Nil(collectionstest.TestEnumerator(collections.Zip(l, collections.NewList(10, 20), sum).GetEnumerator(), 11, 22))
+}
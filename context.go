@@ -0,0 +1,63 @@
+package collections
+
+import "context"
+
+// WithContext returns a lazy enumerable over the collection that stops when ctx is done.
+// Its enumerators check ctx before each MoveNext, and once they have stopped because of it
+// their Err method returns ctx.Err().
+//
+// Wrapping the source of a pipeline stops operators that scan ahead (like Filter) as soon as ctx is done;
+// wrapping the end of a pipeline stops it at the next element it yields.
+func WithContext(ctx context.Context, collection Enumerable) Enumerable {
+	return &contextEnumerable{ctx: ctx, source: collection}
+}
+
+type contextEnumerable struct {
+	ctx    context.Context
+	source Enumerable
+}
+
+func (ce *contextEnumerable) GetEnumerator() Enumerator {
+	return &contextEnumerator{ctx: ce.ctx, source: ce.source.GetEnumerator()}
+}
+
+type contextEnumerator struct {
+	ctx    context.Context
+	source Enumerator
+	err    error
+}
+
+func (ce *contextEnumerator) MoveNext() bool {
+	if ce.err != nil {
+		return false
+	}
+	if ce.err = ce.ctx.Err(); ce.err != nil {
+		return false
+	}
+	return ce.source.MoveNext()
+}
+
+func (ce *contextEnumerator) GetCurrent() interface{} {
+	if ce.err != nil {
+		return nil
+	}
+	return ce.source.GetCurrent()
+}
+
+// Err returns the error from ctx if the enumerator stopped because ctx was done,
+// or else the error from the source.
+func (ce *contextEnumerator) Err() error {
+	if ce.err != nil {
+		return ce.err
+	}
+	return enumeratorErr(ce.source)
+}
+
+func (ce *contextEnumerator) Close() error {
+	return closeEnumerator(ce.source)
+}
+
+func (ce *contextEnumerator) Reset() {
+	ce.err = nil
+	ce.source.Reset()
+}
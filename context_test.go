@@ -0,0 +1,63 @@
+package collections
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestWithContext(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := WithContext(ctx, NewList(1, 2, 3)).GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+
+	cancel()
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+	a.Equal(context.Canceled, e.(*contextEnumerator).Err())
+}
+
+func TestWithContextStopsScans(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	neverMatches := Filter(WithContext(ctx, &countingEnumerable{}), func(value interface{}) bool {
+		calls = calls + 1
+		if calls == 100 {
+			cancel()
+		}
+		return false
+	})
+
+	_, err := Count(neverMatches, nil)
+	a.Equal(context.Canceled, err)
+	a.Equal(100, calls)
+}
+
+func TestWithContextDeadline(t *testing.T) {
+	a := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	slow := Map(&countingEnumerable{}, func(value interface{}) interface{} {
+		time.Sleep(time.Millisecond)
+		return value
+	})
+
+	e := WithContext(ctx, slow).GetEnumerator()
+	for e.MoveNext() {
+		e.GetCurrent()
+	}
+	a.Equal(context.DeadlineExceeded, e.(*contextEnumerator).Err())
+}
@@ -0,0 +1,226 @@
+package collections
+
+import (
+	"reflect"
+
+	"github.com/blendlabs/go-exception"
+)
+
+// DuplicateKeyPolicy decides what ToMap and ToDictionary do when two elements have the same key.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyError returns an error naming the duplicate key.
+	DuplicateKeyError DuplicateKeyPolicy = iota
+	// DuplicateKeyFirstWins keeps the value of the first element with the key.
+	DuplicateKeyFirstWins
+	// DuplicateKeyLastWins keeps the value of the last element with the key.
+	DuplicateKeyLastWins
+)
+
+// ToSlice fills target, which must be a pointer to a slice, with the elements of the collection.
+// Numeric elements are converted to the element type of the slice and nil elements become its zero value;
+// any other element that is not assignable to it is an error, and target is left unchanged.
+func ToSlice(collection Enumerable, target interface{}) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Slice {
+		return exception.Newf("ToSlice target must be a pointer to a slice, not %v", reflect.TypeOf(target))
+	}
+
+	sliceType := targetValue.Elem().Type()
+	slice := reflect.MakeSlice(sliceType, 0, 0)
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		element, convertErr := convertTo(e.GetCurrent(), sliceType.Elem())
+		if convertErr != nil {
+			return convertErr
+		}
+		slice = reflect.Append(slice, element)
+	}
+	if err := enumeratorErr(e); err != nil {
+		return err
+	}
+
+	targetValue.Elem().Set(slice)
+	return nil
+}
+
+// ToMap fills target, which must be a pointer to a map, with the keys returned by keySelector and the values
+// returned by valueSelector (or the elements themselves if it is nil). Keys and values are converted to the key
+// and value types of the map as ToSlice converts elements; a key or value that cannot be converted is an error,
+// and target is left unchanged. Use ToDictionary for keys or values of mixed types.
+//
+// With a nil keySelector the elements must be KeyValuePairs, which give the keys, and also the values if
+// valueSelector is nil; so ToMap(FromMap(m), &copied, nil, nil, DuplicateKeyError) copies m.
+func ToMap(collection Enumerable, target interface{}, keySelector KeySelector, valueSelector MapAction, policy DuplicateKeyPolicy) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Map {
+		return exception.Newf("ToMap target must be a pointer to a map, not %v", reflect.TypeOf(target))
+	}
+	selectEntry := entrySelectors(keySelector, valueSelector)
+
+	mapType := targetValue.Elem().Type()
+	result := reflect.MakeMap(mapType)
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		key, value, selectErr := selectEntry(e.GetCurrent())
+		if selectErr != nil {
+			return selectErr
+		}
+
+		if keyErr := checkMapKey(key); keyErr != nil {
+			return keyErr
+		}
+		keyValue, keyErr := convertTo(key, mapType.Key())
+		if keyErr != nil {
+			return keyErr
+		}
+		valueValue, valueErr := convertTo(value, mapType.Elem())
+		if valueErr != nil {
+			return valueErr
+		}
+
+		set, policyErr := applyDuplicateKeyPolicy(policy, result.MapIndex(keyValue).IsValid(), key)
+		if policyErr != nil {
+			return policyErr
+		}
+		if set {
+			result.SetMapIndex(keyValue, valueValue)
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return err
+	}
+
+	targetValue.Elem().Set(result)
+	return nil
+}
+
+// ToDictionary is ToMap for keys or values of any type.
+func ToDictionary(collection Enumerable, keySelector KeySelector, valueSelector MapAction, policy DuplicateKeyPolicy) (map[interface{}]interface{}, error) {
+	selectEntry := entrySelectors(keySelector, valueSelector)
+
+	dictionary := map[interface{}]interface{}{}
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		key, value, selectErr := selectEntry(e.GetCurrent())
+		if selectErr != nil {
+			return nil, selectErr
+		}
+
+		if keyErr := checkMapKey(key); keyErr != nil {
+			return nil, keyErr
+		}
+		_, hasKey := dictionary[key]
+		set, policyErr := applyDuplicateKeyPolicy(policy, hasKey, key)
+		if policyErr != nil {
+			return nil, policyErr
+		}
+		if set {
+			dictionary[key] = value
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return dictionary, nil
+}
+
+// ToSet returns the distinct elements of the collection as the keys of a go map; an element that cannot be
+// a map key is an error.
+func ToSet(collection Enumerable) (map[interface{}]struct{}, error) {
+	set := map[interface{}]struct{}{}
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		if keyErr := checkMapKey(current); keyErr != nil {
+			return nil, keyErr
+		}
+		set[current] = struct{}{}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// entrySelector returns the key and value ToMap and ToDictionary store for an element.
+type entrySelector func(value interface{}) (key, entryValue interface{}, err error)
+
+// entrySelectors combines the selectors ToMap and ToDictionary were given, filling in the ones that are nil;
+// with a nil keySelector an element that is not a KeyValuePair is an error.
+func entrySelectors(keySelector KeySelector, valueSelector MapAction) entrySelector {
+	if valueSelector == nil && keySelector != nil {
+		valueSelector = DefaultKeySelector
+	}
+	return func(value interface{}) (interface{}, interface{}, error) {
+		if keySelector != nil {
+			return keySelector(value), valueSelector(value), nil
+		}
+		pair, err := keyValuePairOf(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if valueSelector == nil {
+			return pair.Key, pair.Value, nil
+		}
+		return pair.Key, valueSelector(value), nil
+	}
+}
+
+// checkMapKey returns an error for a key that cannot be a go map key, like a slice, which would panic.
+func checkMapKey(key interface{}) error {
+	if !isComparable(key) {
+		return exception.Newf("%v cannot be a map key", reflect.TypeOf(key))
+	}
+	return nil
+}
+
+// applyDuplicateKeyPolicy returns whether the value for key should be set.
+func applyDuplicateKeyPolicy(policy DuplicateKeyPolicy, hasKey bool, key interface{}) (bool, error) {
+	if !hasKey {
+		return true, nil
+	}
+
+	switch policy {
+	case DuplicateKeyFirstWins:
+		return false, nil
+	case DuplicateKeyLastWins:
+		return true, nil
+	default:
+		return false, exception.Newf("Duplicate key %v", key)
+	}
+}
+
+// ConvertNumber converts a numeric value to the numeric type toType, the way ToSlice and ToMap do: it returns
+// an error rather than a different number if toType cannot represent the value exactly.
+func ConvertNumber(value interface{}, toType reflect.Type) (interface{}, error) {
+	converted, err := castAsNumeric(value, toType)
+	if err != nil {
+		return nil, err
+	}
+	return converted.Interface(), nil
+}
+
+// convertTo returns value as a reflect.Value of the given type, converting between numeric types when
+// the conversion is exact.
+func convertTo(value interface{}, toType reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(toType), nil
+	}
+
+	valueType := reflect.TypeOf(value)
+	if valueType.AssignableTo(toType) {
+		converted := reflect.New(toType).Elem()
+		converted.Set(reflect.ValueOf(value))
+		return converted, nil
+	}
+	if isNumericKind(valueType.Kind()) && isNumericKind(toType.Kind()) {
+		return castAsNumeric(value, toType)
+	}
+	return reflect.Value{}, exception.Newf("Cannot cast %v as %v", valueType, toType)
+}
@@ -0,0 +1,153 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestToSlice(t *testing.T) {
+	a := assert.New(t)
+
+	var ints []int
+	a.Nil(ToSlice(Map(NewList(1, 2, 3), func(value interface{}) interface{} {
+		return value.(int) * 2
+	}), &ints))
+	a.Equal([]int{2, 4, 6}, ints)
+
+	var floats []float64
+	a.Nil(ToSlice(NewList(1, int8(2), float32(3.5)), &floats))
+	a.Equal([]float64{1, 2, 3.5}, floats)
+
+	var pointers []*int
+	a.Nil(ToSlice(NewList(nil), &pointers))
+	a.Equal([]*int{nil}, pointers)
+
+	var values []interface{}
+	a.Nil(ToSlice(NewList(1, "two"), &values))
+	a.Equal([]interface{}{1, "two"}, values)
+
+	strings := []string{"unchanged"}
+	a.NotNil(ToSlice(NewList("one", 2), &strings))
+	a.Equal([]string{"unchanged"}, strings)
+
+	a.NotNil(ToSlice(NewList(1), ints))
+	a.NotNil(ToSlice(NewList(1), nil))
+}
+
+func TestToMap(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(customer{1, "Alice"}, customer{2, "Bob"}, customer{1, "Alicia"})
+	name := func(value interface{}) interface{} {
+		return value.(customer).Name
+	}
+
+	var names map[int]string
+	a.NotNil(ToMap(l, &names, customerId, name, DuplicateKeyError))
+	a.Nil(names)
+
+	a.Nil(ToMap(l, &names, customerId, name, DuplicateKeyFirstWins))
+	a.Equal(map[int]string{1: "Alice", 2: "Bob"}, names)
+
+	a.Nil(ToMap(l, &names, customerId, name, DuplicateKeyLastWins))
+	a.Equal(map[int]string{1: "Alicia", 2: "Bob"}, names)
+
+	var byId map[int]customer
+	a.Nil(ToMap(l, &byId, customerId, nil, DuplicateKeyFirstWins))
+	a.Equal(customer{2, "Bob"}, byId[2])
+
+	empty := map[int]string{1: "replaced"}
+	a.Nil(ToMap(NewList(), &empty, customerId, name, DuplicateKeyError))
+	a.Equal(map[int]string{}, empty)
+
+	var values map[int]interface{}
+	a.Nil(ToMap(NewList(1, "two"), &values, func(value interface{}) interface{} {
+		return len(fmt.Sprint(value))
+	}, nil, DuplicateKeyError))
+	a.Equal(map[int]interface{}{1: 1, 3: "two"}, values)
+
+	var ints map[int]int
+	a.NotNil(ToMap(NewList(1, "two"), &ints, DefaultKeySelector, nil, DuplicateKeyError))
+	a.NotNil(ToMap(NewList(1), ints, DefaultKeySelector, nil, DuplicateKeyError))
+	a.NotNil(ToMap(NewList(1), &[]int{}, DefaultKeySelector, nil, DuplicateKeyError))
+}
+
+func TestConvertLosingPrecision(t *testing.T) {
+	a := assert.New(t)
+
+	var ints []int
+	a.NotNil(ToSlice(NewList(2.7), &ints))
+	a.NotNil(ToSlice(NewList(300), &[]uint8{}))
+	a.NotNil(ToSlice(NewList(-1), &[]uint{}))
+	a.Nil(ToSlice(NewList(2.0, int8(3)), &ints))
+	a.Equal([]int{2, 3}, ints)
+
+	var byKey map[int]string
+	a.NotNil(ToMap(NewList(1, 1.5), &byKey, DefaultKeySelector, func(value interface{}) interface{} {
+		return fmt.Sprint(value)
+	}, DuplicateKeyLastWins))
+	a.Nil(byKey)
+}
+
+func TestToDictionary(t *testing.T) {
+	a := assert.New(t)
+
+	dictionary, err := ToDictionary(NewList(1, "two", 3), DefaultKeySelector, func(value interface{}) interface{} {
+		_, isInt := value.(int)
+		return isInt
+	}, DuplicateKeyError)
+	a.Nil(err)
+	a.Equal(map[interface{}]interface{}{1: true, "two": false, 3: true}, dictionary)
+
+	_, err = ToDictionary(NewList(1, 1), DefaultKeySelector, nil, DuplicateKeyError)
+	a.NotNil(err)
+}
+
+func TestToSet(t *testing.T) {
+	a := assert.New(t)
+
+	set, err := ToSet(NewList(1, 2, 1, 3))
+	a.Nil(err)
+	a.Len(set, 3)
+	_, hasTwo := set[2]
+	a.True(hasTwo)
+}
+
+func TestConvertNumber(t *testing.T) {
+	a := assert.New(t)
+
+	converted, err := ConvertNumber(int8(3), reflect.TypeOf(float32(0)))
+	a.Nil(err)
+	a.Equal(float32(3), converted)
+
+	_, err = ConvertNumber(2.5, reflect.TypeOf(0))
+	a.NotNil(err)
+	_, err = ConvertNumber("3", reflect.TypeOf(0))
+	a.NotNil(err)
+	_, err = ConvertNumber(nil, reflect.TypeOf(0))
+	a.NotNil(err)
+}
+
+func TestConvertUnhashableKeys(t *testing.T) {
+	a := assert.New(t)
+
+	slices := &List{contents: []interface{}{[]int{1}, []int{2}}}
+
+	_, err := ToSet(slices)
+	a.NotNil(err)
+
+	_, err = ToDictionary(slices, DefaultKeySelector, nil, DuplicateKeyError)
+	a.NotNil(err)
+
+	var byKey map[interface{}]int
+	a.NotNil(ToMap(slices, &byKey, DefaultKeySelector, func(value interface{}) interface{} {
+		return len(value.([]int))
+	}, DuplicateKeyError))
+	a.Nil(byKey)
+
+	_, err = ToSet(&List{contents: []interface{}{[1]interface{}{[]int{1}}}})
+	a.NotNil(err)
+}
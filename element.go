@@ -0,0 +1,145 @@
+package collections
+
+// The element operators below treat a nil predicate as matching every element.
+
+// First returns the first element that matches the predicate, or ErrNoElements.
+func First(collection Enumerable, predicate Predicate) (interface{}, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		if predicate == nil || predicate(current) {
+			return current, nil
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return nil, ErrNoElements
+}
+
+// FirstOrDefault returns the first element that matches the predicate, or defaultValue if there are none.
+// It still returns the error if the collection fails.
+func FirstOrDefault(collection Enumerable, predicate Predicate, defaultValue interface{}) (interface{}, error) {
+	value, err := First(collection, predicate)
+	if err == ErrNoElements {
+		return defaultValue, nil
+	}
+	return value, err
+}
+
+// Last returns the last element that matches the predicate, or ErrNoElements.
+// A *List is searched from the end rather than enumerated.
+func Last(collection Enumerable, predicate Predicate) (interface{}, error) {
+	if typedCollection, isList := collection.(*List); isList {
+		for index := typedCollection.Len() - 1; index >= 0; index-- {
+			current := typedCollection.contents[index]
+			if predicate == nil || predicate(current) {
+				return current, nil
+			}
+		}
+		return nil, ErrNoElements
+	}
+
+	var last interface{}
+	found := false
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		if predicate == nil || predicate(current) {
+			last, found = current, true
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoElements
+	}
+	return last, nil
+}
+
+// LastOrDefault returns the last element that matches the predicate, or defaultValue if there are none.
+// It still returns the error if the collection fails.
+func LastOrDefault(collection Enumerable, predicate Predicate, defaultValue interface{}) (interface{}, error) {
+	value, err := Last(collection, predicate)
+	if err == ErrNoElements {
+		return defaultValue, nil
+	}
+	return value, err
+}
+
+// Single returns the only element that matches the predicate, ErrNoElements if there are none, or
+// ErrMoreThanOneElement if there are more. It stops at the second match.
+func Single(collection Enumerable, predicate Predicate) (interface{}, error) {
+	var single interface{}
+	found := false
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		if predicate == nil || predicate(current) {
+			if found {
+				return nil, ErrMoreThanOneElement
+			}
+			single, found = current, true
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoElements
+	}
+	return single, nil
+}
+
+// SingleOrDefault returns the only element that matches the predicate, or defaultValue if there are none.
+// It still returns ErrMoreThanOneElement if there is more than one.
+func SingleOrDefault(collection Enumerable, predicate Predicate, defaultValue interface{}) (interface{}, error) {
+	value, err := Single(collection, predicate)
+	if err == ErrNoElements {
+		return defaultValue, nil
+	}
+	return value, err
+}
+
+// ElementAt returns the element at the given index, or ErrIndexOutOfRange.
+// A *List is indexed directly rather than enumerated.
+func ElementAt(collection Enumerable, index int) (interface{}, error) {
+	if index < 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
+	if typedCollection, isList := collection.(*List); isList {
+		if index >= typedCollection.Len() {
+			return nil, ErrIndexOutOfRange
+		}
+		return typedCollection.contents[index], nil
+	}
+
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for current := 0; e.MoveNext(); current++ {
+		if current == index {
+			return e.GetCurrent(), nil
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return nil, err
+	}
+	return nil, ErrIndexOutOfRange
+}
+
+// ElementAtOrDefault returns the element at the given index, or defaultValue if it is out of range.
+// It still returns the error if the collection fails.
+func ElementAtOrDefault(collection Enumerable, index int, defaultValue interface{}) (interface{}, error) {
+	value, err := ElementAt(collection, index)
+	if err == ErrIndexOutOfRange {
+		return defaultValue, nil
+	}
+	return value, err
+}
@@ -0,0 +1,130 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/blendlabs/go-exception"
+)
+
+func TestFirst(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(nil, 1, 2, 3)
+	first, err := First(l, nil)
+	a.Nil(err)
+	a.Nil(first)
+
+	first, err = First(l, func(value interface{}) bool {
+		return value != nil && value.(int) > 1
+	})
+	a.Nil(err)
+	a.Equal(2, first)
+
+	_, err = First(NewList(1, 2), lessThan(0))
+	a.Equal(ErrNoElements, err)
+
+	value, err := FirstOrDefault(NewList(1, 2), lessThan(0), -1)
+	a.Nil(err)
+	a.Equal(-1, value)
+	value, err = FirstOrDefault(NewList(1, 2), nil, -1)
+	a.Nil(err)
+	a.Equal(1, value)
+
+	failure := exception.New("read failed")
+	_, err = FirstOrDefault(&failingEnumerable{values: []interface{}{1, 2}, err: failure}, lessThan(0), -1)
+	a.Equal(failure, err)
+}
+
+func TestLast(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4)
+	last, err := Last(l, lessThan(3))
+	a.Nil(err)
+	a.Equal(2, last)
+
+	last, err = Last(Map(l, DefaultKeySelector), lessThan(3))
+	a.Nil(err)
+	a.Equal(2, last)
+
+	last, err = Last(NewList(1, nil), nil)
+	a.Nil(err)
+	a.Nil(last)
+
+	_, err = Last(l, lessThan(0))
+	a.Equal(ErrNoElements, err)
+	_, err = Last(Map(l, DefaultKeySelector), lessThan(0))
+	a.Equal(ErrNoElements, err)
+
+	last, err = LastOrDefault(l, nil, -1)
+	a.Nil(err)
+	a.Equal(4, last)
+	last, err = LastOrDefault(NewList(), nil, -1)
+	a.Nil(err)
+	a.Equal(-1, last)
+
+	failure := exception.New("read failed")
+	_, err = LastOrDefault(&failingEnumerable{values: []interface{}{1, 2}, err: failure}, nil, -1)
+	a.Equal(failure, err)
+}
+
+func TestSingle(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3)
+	single, err := Single(l, func(value interface{}) bool {
+		return value.(int) == 2
+	})
+	a.Nil(err)
+	a.Equal(2, single)
+
+	_, err = Single(l, lessThan(3))
+	a.Equal(ErrMoreThanOneElement, err)
+
+	_, err = Single(l, lessThan(0))
+	a.Equal(ErrNoElements, err)
+
+	source := &countingEnumerable{}
+	_, err = Single(source, nil)
+	a.Equal(ErrMoreThanOneElement, err)
+	a.Equal(2, source.pulled)
+
+	value, err := SingleOrDefault(l, lessThan(0), -1)
+	a.Nil(err)
+	a.Equal(-1, value)
+
+	_, err = SingleOrDefault(l, nil, -1)
+	a.Equal(ErrMoreThanOneElement, err)
+}
+
+func TestElementAt(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3)
+	value, err := ElementAt(l, 1)
+	a.Nil(err)
+	a.Equal(2, value)
+
+	value, err = ElementAt(&countingEnumerable{}, 5)
+	a.Nil(err)
+	a.Equal(5, value)
+
+	_, err = ElementAt(l, 3)
+	a.Equal(ErrIndexOutOfRange, err)
+	_, err = ElementAt(Map(l, DefaultKeySelector), 3)
+	a.Equal(ErrIndexOutOfRange, err)
+	_, err = ElementAt(l, -1)
+	a.Equal(ErrIndexOutOfRange, err)
+
+	value, err = ElementAtOrDefault(l, 2, -1)
+	a.Nil(err)
+	a.Equal(3, value)
+	value, err = ElementAtOrDefault(l, 5, -1)
+	a.Nil(err)
+	a.Equal(-1, value)
+
+	failure := exception.New("read failed")
+	_, err = ElementAtOrDefault(&failingEnumerable{values: []interface{}{1, 2}, err: failure}, 5, -1)
+	a.Equal(failure, err)
+}
@@ -1,30 +1,107 @@
 package collections
 
+import "sort"
+
 // --------------------------------------------------------------------------------
 // exported interfaces
 // --------------------------------------------------------------------------------
 
+// Enumerable is a collection that can be enumerated.
 type Enumerable interface {
 	GetEnumerator() Enumerator
 }
 
+// Enumerator walks a collection one element at a time.
+//
+// A new (or Reset) enumerator is positioned before the first element; MoveNext must be called
+// before the first GetCurrent. MoveNext advances to the next element and returns false once there
+// are no more elements, after which it keeps returning false and GetCurrent returns nil.
+// Reset rewinds the enumerator to before the first element.
 type Enumerator interface {
 	MoveNext() bool
 	GetCurrent() interface{}
 	Reset()
 }
 
+// ErrEnumerator is an Enumerator over a source that can fail, like a parser or a reader. Once MoveNext
+// returns false, Err reports the error that stopped it, or nil if it reached the end of the collection.
+// The operators in this package implement ErrEnumerator and stop at, and report, errors from their sources.
+type ErrEnumerator interface {
+	Enumerator
+	Err() error
+}
+
+// ClosableEnumerator is an Enumerator that holds a resource, like a file or a database cursor, which Close
+// releases. The operators in this package implement ClosableEnumerator and close their sources when they
+// are closed, and the functions that consume a collection close its enumerator when they return,
+// including when they stop early.
+type ClosableEnumerator interface {
+	Enumerator
+	Close() error
+}
+
+// --------------------------------------------------------------------------------
+// error helpers
+// --------------------------------------------------------------------------------
+
+// enumeratorErr returns the error from an ErrEnumerator, or nil for any other Enumerator.
+func enumeratorErr(e Enumerator) error {
+	if typed, isErrEnumerator := e.(ErrEnumerator); isErrEnumerator {
+		return typed.Err()
+	}
+	return nil
+}
+
+// closeEnumerator closes a ClosableEnumerator, and does nothing for any other Enumerator.
+func closeEnumerator(e Enumerator) error {
+	if typed, isClosable := e.(ClosableEnumerator); isClosable {
+		return typed.Close()
+	}
+	return nil
+}
+
+// errorEnumerator is an empty enumerator that reports an error, for operators that fail before
+// they yield anything.
+type errorEnumerator struct {
+	err error
+}
+
+func (ee *errorEnumerator) MoveNext() bool {
+	return false
+}
+
+func (ee *errorEnumerator) GetCurrent() interface{} {
+	return nil
+}
+
+func (ee *errorEnumerator) Err() error {
+	return ee.err
+}
+
+func (ee *errorEnumerator) Reset() {}
+
 // --------------------------------------------------------------------------------
 // mapEnumerator
 // --------------------------------------------------------------------------------
 
+// KeyValuePair is an entry of a go map, as yielded by the map enumerators.
+type KeyValuePair struct {
+	Key   interface{}
+	Value interface{}
+}
+
 type mapEnumerator struct {
 	length   int
 	index    int
-	keys     []interface{}
+	entries  []KeyValuePair
 	contents interface{}
+	sorted   bool
+	comparer Comparer
+	err      error
 }
 
+// NewMapEnumerator returns an enumerator over the entries of a go map as KeyValuePairs, in go's
+// unspecified map order. The entries are read when it is created and when it is Reset.
 func NewMapEnumerator(contents interface{}) *mapEnumerator {
 	if contents == nil {
 		return nil
@@ -35,41 +112,95 @@ func NewMapEnumerator(contents interface{}) *mapEnumerator {
 	}
 
 	se := mapEnumerator{}
-	se.length = getMapLength(contents)
-	se.index = 0
-	se.keys = getMapKeys(contents)
+	se.entries = getMapEntries(contents)
+	se.length = len(se.entries)
+	se.index = -1
 	se.contents = contents
 	return &se
 }
 
+// NewSortedMapEnumerator is NewMapEnumerator with the entries ordered by key, compared with comparer or, if it
+// is nil, with the comparer for the type of the keys. If the keys cannot be compared the enumerator is empty
+// and its Err method returns the error.
+func NewSortedMapEnumerator(contents interface{}, comparer Comparer) *mapEnumerator {
+	se := NewMapEnumerator(contents)
+	if se == nil {
+		return nil
+	}
+
+	se.sorted, se.comparer = true, comparer
+	se.err = se.sortEntries()
+	return se
+}
+
 func (se *mapEnumerator) MoveNext() bool {
-	if se.index >= se.length {
+	if se.err != nil || se.index+1 >= se.length {
+		se.index = se.length
 		return false
 	}
 
 	se.index = se.index + 1
-	return se.index < se.length
+	return true
 }
 
+// GetCurrent returns the current entry as a KeyValuePair.
 func (se mapEnumerator) GetCurrent() interface{} {
-	if se.index < se.length {
-		key := se.keys[se.index]
-		return elementAtKey(se.contents, key)
+	if se.index >= 0 && se.index < se.length {
+		return se.entries[se.index]
 	}
 	return nil
 }
 
 func (se mapEnumerator) GetCurrentWithKey() (interface{}, interface{}) {
-	if se.index < se.length {
-		key := se.keys[se.index]
-		return key, elementAtKey(se.contents, key)
+	if se.index >= 0 && se.index < se.length {
+		entry := se.entries[se.index]
+		return entry.Key, entry.Value
 	}
 	return nil, nil
 }
 
+// Err returns the error from comparing the keys of a sorted map enumerator.
+func (se *mapEnumerator) Err() error {
+	return se.err
+}
+
+// Reset re-reads the entries of the map, so the enumeration sees the entries added, changed or removed since
+// it started. If the map still has the same keys they are enumerated in the same order again.
 func (se *mapEnumerator) Reset() {
-	se.index = 0
-	se.length = getMapLength(se.contents)
+	se.index = -1
+	if refreshMapEntries(se.contents, se.entries) {
+		return
+	}
+	se.entries = getMapEntries(se.contents)
+	se.length = len(se.entries)
+	se.err = nil
+	if se.sorted {
+		se.err = se.sortEntries()
+	}
+}
+
+func (se *mapEnumerator) sortEntries() error {
+	comparer := se.comparer
+	if comparer == nil {
+		keys := make([]interface{}, len(se.entries))
+		for index, entry := range se.entries {
+			keys[index] = entry.Key
+		}
+		var comparerErr error
+		if comparer, comparerErr = getComparerForValues(keys); comparerErr != nil {
+			return comparerErr
+		}
+	}
+
+	var compareErr error
+	sort.SliceStable(se.entries, func(i, j int) bool {
+		compareResult, err := compareNilFirst(comparer, se.entries[i].Key, se.entries[j].Key)
+		if err != nil && compareErr == nil {
+			compareErr = err
+		}
+		return compareResult < 0
+	})
+	return compareErr
 }
 
 // --------------------------------------------------------------------------------
@@ -93,27 +224,28 @@ func NewSliceEnumerator(contents interface{}) *sliceEnumerator {
 
 	se := sliceEnumerator{}
 	se.length = getSliceLength(contents)
-	se.index = 0
+	se.index = -1
 	se.contents = contents
 	return &se
 }
 
 func (se *sliceEnumerator) MoveNext() bool {
-	if se.index >= se.length {
+	if se.index+1 >= se.length {
+		se.index = se.length
 		return false
 	}
 	se.index = se.index + 1
-	return se.index < se.length
+	return true
 }
 
 func (se sliceEnumerator) GetCurrent() interface{} {
-	if se.index < se.length {
+	if se.index >= 0 && se.index < se.length {
 		return elementAtIndex(se.contents, se.index)
 	}
 	return nil
 }
 
 func (se *sliceEnumerator) Reset() {
-	se.index = 0
+	se.index = -1
 	se.length = getSliceLength(se.contents)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/blendlabs/go-assert"
+	"github.com/blendlabs/go-exception"
 )
 
 func TestIsSlice(t *testing.T) {
@@ -73,7 +74,9 @@ func TestSliceEnumeraotr(t *testing.T) {
 	se := NewSliceEnumerator(mySlice)
 	a.NotNil(se)
 	a.Equal(se.length, 4)
+	a.Nil(se.GetCurrent())
 
+	a.True(se.MoveNext())
 	firstValue := se.GetCurrent()
 	a.Equal(firstValue, 1)
 
@@ -89,9 +92,12 @@ func TestSliceEnumeraotr(t *testing.T) {
 	fourthValue := se.GetCurrent()
 	a.Equal(fourthValue, 4)
 
+	a.False(se.MoveNext())
+	a.Nil(se.GetCurrent())
 	a.False(se.MoveNext())
 	se.Reset()
 
+	a.True(se.MoveNext())
 	newFirstValue := se.GetCurrent()
 	a.Equal(newFirstValue, 1)
 }
@@ -104,10 +110,11 @@ func TestMapEnumeraotr(t *testing.T) {
 	a.NotNil(me)
 	a.Equal(me.length, 3)
 
-	firstKey := me.keys[0]
+	firstKey := me.entries[0].Key
 
+	a.True(me.MoveNext())
 	firstValue := me.GetCurrent()
-	a.Equal(myMap[firstKey.(string)], firstValue)
+	a.Equal(KeyValuePair{Key: firstKey, Value: myMap[firstKey.(string)]}, firstValue)
 
 	key, value := me.GetCurrentWithKey()
 	a.Equal(firstKey, key)
@@ -115,14 +122,14 @@ func TestMapEnumeraotr(t *testing.T) {
 
 	a.True(me.MoveNext())
 
-	secondKey := me.keys[1]
+	secondKey := me.entries[1].Key
 	key, value = me.GetCurrentWithKey()
 	a.Equal(secondKey, key)
 	a.Equal(myMap[secondKey.(string)], value)
 
 	a.True(me.MoveNext())
 
-	thirdKey := me.keys[2]
+	thirdKey := me.entries[2].Key
 	key, value = me.GetCurrentWithKey()
 	a.Equal(thirdKey, key)
 	a.Equal(myMap[thirdKey.(string)], value)
@@ -131,7 +138,268 @@ func TestMapEnumeraotr(t *testing.T) {
 
 	me.Reset()
 
+	a.True(me.MoveNext())
 	key, value = me.GetCurrentWithKey()
-	a.Equal(firstKey, key)
-	a.Equal(myMap[firstKey.(string)], value)
+	a.Equal(me.entries[0].Key, key)
+	a.Equal(myMap[key.(string)], value)
+}
+
+func TestMapEnumeratorResetAfterReplacingAKey(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[string]int{"foo": 1, "bar": 2}
+	me := NewMapEnumerator(myMap)
+	a.True(me.MoveNext())
+
+	delete(myMap, "foo")
+	myMap["baz"] = 3
+	me.Reset()
+
+	values := map[interface{}]interface{}{}
+	for me.MoveNext() {
+		pair := me.GetCurrent().(KeyValuePair)
+		values[pair.Key] = pair.Value
+	}
+	a.Equal(map[interface{}]interface{}{"bar": 2, "baz": 3}, values)
+}
+
+func TestSliceEnumeratorEmpty(t *testing.T) {
+	a := assert.New(t)
+
+	se := NewSliceEnumerator([]int{})
+	a.False(se.MoveNext())
+	a.Nil(se.GetCurrent())
+	se.Reset()
+	a.False(se.MoveNext())
+}
+
+// must returns a function that checks the error from a function returning (T, error) and returns the T.
+func must[T any](a *assert.Assertions) func(T, error) T {
+	return func(value T, err error) T {
+		a.Nil(err)
+		return value
+	}
+}
+
+// failingEnumerable yields its values and then fails with err.
+type failingEnumerable struct {
+	values []interface{}
+	err    error
+}
+
+func (fe *failingEnumerable) GetEnumerator() Enumerator {
+	return &failingEnumerator{values: fe.values, err: fe.err, index: -1}
+}
+
+type failingEnumerator struct {
+	values []interface{}
+	err    error
+	index  int
+}
+
+func (fe *failingEnumerator) MoveNext() bool {
+	if fe.index+1 >= len(fe.values) {
+		fe.index = len(fe.values)
+		return false
+	}
+	fe.index = fe.index + 1
+	return true
+}
+
+func (fe *failingEnumerator) GetCurrent() interface{} {
+	if fe.index < 0 || fe.index >= len(fe.values) {
+		return nil
+	}
+	return fe.values[fe.index]
+}
+
+func (fe *failingEnumerator) Err() error {
+	if fe.index >= len(fe.values) {
+		return fe.err
+	}
+	return nil
+}
+
+func (fe *failingEnumerator) Reset() {
+	fe.index = -1
+}
+
+func TestErrEnumeratorPropagates(t *testing.T) {
+	a := assert.New(t)
+
+	failure := exception.New("read failed")
+	source := &failingEnumerable{values: []interface{}{1, 2, 3}, err: failure}
+
+	pipelines := []Enumerable{
+		Map(source, func(value interface{}) interface{} { return value.(int) * 2 }),
+		Filter(source, func(value interface{}) bool { return true }),
+		Concat(source, NewList(4, 5)),
+		Skip(source, 1),
+		SkipLast(source, 1),
+		TakeLast(source, 1),
+		Chunk(source, 2),
+		Zip(source, NewList(1, 2, 3, 4), func(first, second interface{}) interface{} { return first }),
+		Distinct(source, nil),
+		GroupBy(source, DefaultKeySelector),
+		SortBy(source, DefaultKeySelector),
+	}
+	for _, pipeline := range pipelines {
+		_, err := Collect(pipeline)
+		a.Equal(failure, err)
+	}
+
+	_, err := Collect(Take(source, 2))
+	a.Nil(err)
+}
+
+func TestErrEnumeratorConcatStopsAtError(t *testing.T) {
+	a := assert.New(t)
+
+	failure := exception.New("read failed")
+	values, err := Collect(Concat(&failingEnumerable{values: []interface{}{1}, err: failure}, NewList(2, 3)))
+	a.Equal(failure, err)
+	a.Equal([]interface{}{1}, values.contents)
+}
+
+func TestErrEnumeratorSinks(t *testing.T) {
+	a := assert.New(t)
+
+	failure := exception.New("read failed")
+	source := &failingEnumerable{values: []interface{}{1, 2, 3}, err: failure}
+
+	_, err := Sum(source)
+	a.Equal(failure, err)
+	_, err = Max(source)
+	a.Equal(failure, err)
+	_, err = Last(source, nil)
+	a.Equal(failure, err)
+	_, err = First(source, func(value interface{}) bool { return value.(int) > 3 })
+	a.Equal(failure, err)
+	_, err = ToDictionary(source, DefaultKeySelector, nil, DuplicateKeyError)
+	a.Equal(failure, err)
+	_, err = AsParallel(source, 2).ToList()
+	a.Equal(failure, err)
+
+	matched, err := Any(source, lessThan(0))
+	a.False(matched)
+	a.Equal(failure, err)
+	all, err := AllMatch(source, lessThan(4))
+	a.False(all)
+	a.Equal(failure, err)
+	_, err = Contains(source, 4, nil)
+	a.Equal(failure, err)
+	equal, err := SequenceEqual(source, NewList(1, 2, 3), nil)
+	a.False(equal)
+	a.Equal(failure, err)
+	_, err = SequenceEqual(NewList(1, 2, 3), source, nil)
+	a.Equal(failure, err)
+	_, err = Count(source, nil)
+	a.Equal(failure, err)
+	_, err = Aggregate(source, 0, func(accumulator, value interface{}) interface{} { return value })
+	a.Equal(failure, err)
+	_, err = ToSet(source)
+	a.Equal(failure, err)
+
+	a.Equal([]interface{}{1, 2, 3}, ToList(source).(*List).contents)
+	values, err := Collect(source)
+	a.Equal(failure, err)
+	a.Equal([]interface{}{1, 2, 3}, values.contents)
+
+	first, err := First(source, nil)
+	a.Nil(err)
+	a.Equal(1, first)
+}
+
+// closingEnumerable is an infinite 0, 1, 2, ... source that counts how many of its enumerators are closed.
+type closingEnumerable struct {
+	closed int
+}
+
+func (ce *closingEnumerable) GetEnumerator() Enumerator {
+	return &closingEnumerator{parent: ce, current: -1}
+}
+
+type closingEnumerator struct {
+	parent  *closingEnumerable
+	current int
+}
+
+func (ce *closingEnumerator) MoveNext() bool {
+	ce.current = ce.current + 1
+	return true
+}
+
+func (ce *closingEnumerator) GetCurrent() interface{} {
+	return ce.current
+}
+
+func (ce *closingEnumerator) Close() error {
+	ce.parent.closed = ce.parent.closed + 1
+	return nil
+}
+
+func (ce *closingEnumerator) Reset() {
+	ce.current = -1
+}
+
+func TestClosableEnumeratorShortCircuit(t *testing.T) {
+	a := assert.New(t)
+
+	source := &closingEnumerable{}
+	pipeline := Take(Filter(Map(source, func(value interface{}) interface{} {
+		return value.(int) * 2
+	}), func(value interface{}) bool {
+		return value.(int)%3 == 0
+	}), 10)
+
+	first, err := First(pipeline, func(value interface{}) bool { return value.(int) > 10 })
+	a.Nil(err)
+	a.Equal(12, first)
+	a.Equal(1, source.closed)
+
+	a.True(must[bool](a)(Any(source, nil)))
+	a.Equal(2, source.closed)
+
+	a.False(must[bool](a)(SequenceEqual(source, NewList(0, 1), nil)))
+	a.Equal(3, source.closed)
+
+	for value := range All(source) {
+		if value.(int) == 3 {
+			break
+		}
+	}
+	a.Equal(4, source.closed)
+}
+
+func TestClosableEnumeratorConcat(t *testing.T) {
+	a := assert.New(t)
+
+	first, second := &closingEnumerable{}, &closingEnumerable{}
+	var values []interface{}
+	a.Nil(ForEach(Concat(Take(first, 2), second), func(value interface{}) bool {
+		values = append(values, value)
+		return len(values) < 3
+	}))
+	a.Equal([]interface{}{0, 1, 0}, values)
+	a.Equal(1, first.closed)
+	a.Equal(1, second.closed)
+}
+
+func TestForEach(t *testing.T) {
+	a := assert.New(t)
+
+	var values []interface{}
+	source := &closingEnumerable{}
+	err := ForEach(source, func(value interface{}) bool {
+		values = append(values, value)
+		return len(values) < 3
+	})
+	a.Nil(err)
+	a.Equal([]interface{}{0, 1, 2}, values)
+	a.Equal(1, source.closed)
+
+	failure := exception.New("read failed")
+	a.Equal(failure, ForEach(&failingEnumerable{values: []interface{}{1}, err: failure}, func(value interface{}) bool {
+		return true
+	}))
 }
@@ -0,0 +1,15 @@
+package collections
+
+import "github.com/blendlabs/go-exception"
+
+var (
+	// ErrNoElements is returned by operators that need at least one element when the collection is
+	// empty, or when no element matches the predicate.
+	ErrNoElements error = exception.New("collection contains no matching elements")
+
+	// ErrMoreThanOneElement is returned by Single when more than one element matches the predicate.
+	ErrMoreThanOneElement error = exception.New("collection contains more than one matching element")
+
+	// ErrIndexOutOfRange is returned by ElementAt when the index is negative or past the end of the collection.
+	ErrIndexOutOfRange error = exception.New("index out of range")
+)
@@ -0,0 +1,167 @@
+package collections
+
+// UnfoldAction returns the next value to yield from state and the state to continue from,
+// or false to end the sequence.
+type UnfoldAction func(state interface{}) (value, next interface{}, ok bool)
+
+// Range returns a lazy enumerable of count ints starting at start, each step more than the one before it.
+// A negative count is treated as zero.
+func Range(start, count, step int) Enumerable {
+	if count < 0 {
+		count = 0
+	}
+	return &rangeEnumerable{start: start, count: count, step: step}
+}
+
+// Repeat returns a lazy enumerable of value count times. A negative count is treated as zero.
+func Repeat(value interface{}, count int) Enumerable {
+	if count < 0 {
+		count = 0
+	}
+	return &repeatEnumerable{value: value, count: count}
+}
+
+// Empty returns an enumerable with no elements.
+func Empty() Enumerable {
+	return &repeatEnumerable{}
+}
+
+// Generate returns a lazy enumerable of seed, next(seed), next(next(seed)) and so on, up to the first value
+// for which until returns true, which is not yielded. A nil until never ends the sequence; use Take to bound it.
+func Generate(seed interface{}, next MapAction, until Predicate) Enumerable {
+	return Unfold(seed, func(state interface{}) (interface{}, interface{}, bool) {
+		if until != nil && until(state) {
+			return nil, nil, false
+		}
+		return state, next(state), true
+	})
+}
+
+// Unfold returns a lazy enumerable of the values returned by fn, starting from seed and continuing from
+// the state it returns each time, until it returns false.
+func Unfold(seed interface{}, fn UnfoldAction) Enumerable {
+	return &unfoldEnumerable{seed: seed, fn: fn}
+}
+
+// --------------------------------------------------------------------------------
+// rangeEnumerable
+// --------------------------------------------------------------------------------
+
+type rangeEnumerable struct {
+	start int
+	count int
+	step  int
+}
+
+func (re *rangeEnumerable) GetEnumerator() Enumerator {
+	return &rangeEnumerator{parent: re, index: -1}
+}
+
+type rangeEnumerator struct {
+	parent *rangeEnumerable
+	index  int
+}
+
+func (re *rangeEnumerator) MoveNext() bool {
+	if re.index+1 >= re.parent.count {
+		re.index = re.parent.count
+		return false
+	}
+	re.index = re.index + 1
+	return true
+}
+
+func (re *rangeEnumerator) GetCurrent() interface{} {
+	if re.index < 0 || re.index >= re.parent.count {
+		return nil
+	}
+	return re.parent.start + re.index*re.parent.step
+}
+
+func (re *rangeEnumerator) Reset() {
+	re.index = -1
+}
+
+// --------------------------------------------------------------------------------
+// repeatEnumerable
+// --------------------------------------------------------------------------------
+
+type repeatEnumerable struct {
+	value interface{}
+	count int
+}
+
+func (re *repeatEnumerable) GetEnumerator() Enumerator {
+	return &repeatEnumerator{parent: re, index: -1}
+}
+
+type repeatEnumerator struct {
+	parent *repeatEnumerable
+	index  int
+}
+
+func (re *repeatEnumerator) MoveNext() bool {
+	if re.index+1 >= re.parent.count {
+		re.index = re.parent.count
+		return false
+	}
+	re.index = re.index + 1
+	return true
+}
+
+func (re *repeatEnumerator) GetCurrent() interface{} {
+	if re.index < 0 || re.index >= re.parent.count {
+		return nil
+	}
+	return re.parent.value
+}
+
+func (re *repeatEnumerator) Reset() {
+	re.index = -1
+}
+
+// --------------------------------------------------------------------------------
+// unfoldEnumerable
+// --------------------------------------------------------------------------------
+
+type unfoldEnumerable struct {
+	seed interface{}
+	fn   UnfoldAction
+}
+
+func (ue *unfoldEnumerable) GetEnumerator() Enumerator {
+	return &unfoldEnumerator{parent: ue, state: ue.seed}
+}
+
+type unfoldEnumerator struct {
+	parent  *unfoldEnumerable
+	state   interface{}
+	done    bool
+	valid   bool
+	current interface{}
+}
+
+func (ue *unfoldEnumerator) MoveNext() bool {
+	if ue.done {
+		return false
+	}
+
+	value, next, ok := ue.parent.fn(ue.state)
+	if !ok {
+		ue.done, ue.valid, ue.current = true, false, nil
+		return false
+	}
+	ue.state, ue.valid, ue.current = next, true, value
+	return true
+}
+
+func (ue *unfoldEnumerator) GetCurrent() interface{} {
+	if !ue.valid {
+		return nil
+	}
+	return ue.current
+}
+
+func (ue *unfoldEnumerator) Reset() {
+	ue.state, ue.done, ue.valid, ue.current = ue.parent.seed, false, false, nil
+}
@@ -0,0 +1,68 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestRange(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{0, 1, 2, 3}, contentsOf(Range(0, 4, 1)))
+	a.Equal([]interface{}{10, 7, 4}, contentsOf(Range(10, 3, -3)))
+	a.Equal([]interface{}{5, 5}, contentsOf(Range(5, 2, 0)))
+	a.Empty(contentsOf(Range(0, -1, 1)))
+
+	evens := Filter(Range(1, 10, 1), func(value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	sum, err := Sum(evens)
+	a.Nil(err)
+	a.Equal(30.0, sum)
+}
+
+func TestRepeat(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{"x", "x", "x"}, contentsOf(Repeat("x", 3)))
+	a.Empty(contentsOf(Repeat("x", 0)))
+	a.Empty(contentsOf(Repeat("x", -2)))
+	a.Empty(contentsOf(Empty()))
+	a.Equal(0, must[int](a)(Count(Empty(), nil)))
+}
+
+func TestGenerate(t *testing.T) {
+	a := assert.New(t)
+
+	powers := Generate(1, func(value interface{}) interface{} {
+		return value.(int) * 2
+	}, nil)
+	a.Equal([]interface{}{1, 2, 4, 8, 16}, contentsOf(Take(powers, 5)))
+
+	countdown := Generate(3, func(value interface{}) interface{} {
+		return value.(int) - 1
+	}, func(value interface{}) bool {
+		return value.(int) == 0
+	})
+	a.Equal([]interface{}{3, 2, 1}, contentsOf(countdown))
+}
+
+func TestUnfold(t *testing.T) {
+	a := assert.New(t)
+
+	fibonacci := Unfold(Pair{First: 0, Second: 1}, func(state interface{}) (interface{}, interface{}, bool) {
+		pair := state.(Pair)
+		return pair.First, Pair{First: pair.Second, Second: pair.First.(int) + pair.Second.(int)}, true
+	})
+	a.Equal([]interface{}{0, 1, 1, 2, 3, 5, 8}, contentsOf(Take(fibonacci, 7)))
+
+	digits := Unfold(1234, func(state interface{}) (interface{}, interface{}, bool) {
+		if state.(int) == 0 {
+			return nil, nil, false
+		}
+		return state.(int) % 10, state.(int) / 10, true
+	})
+	a.Equal([]interface{}{4, 3, 2, 1}, contentsOf(digits))
+	a.Equal([]interface{}{4, 3, 2, 1}, contentsOf(digits))
+}
@@ -0,0 +1,166 @@
+package collections
+
+// GroupResultSelector projects a key and the elements that share it into a result.
+type GroupResultSelector func(key interface{}, elements Enumerable) interface{}
+
+// GroupBy returns a lazy enumerable of *Grouping, one for each distinct key returned by keySelector,
+// in the order the keys are first seen. Keys are compared with ==, or with reflect.DeepEqual for keys like
+// slices and maps that == cannot compare.
+func GroupBy(collection Enumerable, keySelector KeySelector) Enumerable {
+	return GroupBySelect(collection, keySelector, nil, nil)
+}
+
+// GroupBySelect is GroupBy with each element projected by elementSelector before it is grouped, and each
+// group projected by resultSelector. A nil elementSelector keeps the elements as is, and a nil
+// resultSelector yields the *Grouping itself.
+func GroupBySelect(collection Enumerable, keySelector KeySelector, elementSelector MapAction, resultSelector GroupResultSelector) Enumerable {
+	return &groupByEnumerable{
+		source:          collection,
+		keySelector:     keySelector,
+		elementSelector: elementSelector,
+		resultSelector:  resultSelector,
+	}
+}
+
+// ToLookup groups the collection by the keys returned by keySelector into a Lookup. Keys are compared as for GroupBy.
+func ToLookup(collection Enumerable, keySelector KeySelector) *Lookup {
+	return newLookup(collection, keySelector, nil)
+}
+
+// --------------------------------------------------------------------------------
+// Grouping
+// --------------------------------------------------------------------------------
+
+// Grouping is a key and the elements that share it.
+type Grouping struct {
+	key      interface{}
+	elements *List
+}
+
+func (g *Grouping) Key() interface{} {
+	return g.key
+}
+
+func (g *Grouping) Len() int {
+	return g.elements.Len()
+}
+
+func (g *Grouping) GetEnumerator() Enumerator {
+	return g.elements.GetEnumerator()
+}
+
+// --------------------------------------------------------------------------------
+// Lookup
+// --------------------------------------------------------------------------------
+
+// Lookup is a set of groupings indexed by key. Enumerating it yields each *Grouping in the order
+// its key was first seen.
+type Lookup struct {
+	groupings []*Grouping
+	index     map[interface{}]*Grouping
+	buckets   map[uint64][]*Grouping
+	err       error
+}
+
+func newLookup(collection Enumerable, keySelector KeySelector, elementSelector MapAction) *Lookup {
+	l := &Lookup{index: map[interface{}]*Grouping{}, buckets: map[uint64][]*Grouping{}}
+
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		key := keySelector(current)
+
+		grouping, hasGrouping := l.find(key)
+		if !hasGrouping {
+			grouping = l.add(key)
+		}
+
+		if elementSelector != nil {
+			current = elementSelector(current)
+		}
+		grouping.elements.Add(current)
+	}
+	l.err = enumeratorErr(e)
+	return l
+}
+
+// Get returns the elements with the given key, which is empty if there are none.
+func (l *Lookup) Get(key interface{}) Enumerable {
+	if grouping, hasGrouping := l.find(key); hasGrouping {
+		return grouping
+	}
+	return &List{}
+}
+
+func (l *Lookup) Contains(key interface{}) bool {
+	_, hasGrouping := l.find(key)
+	return hasGrouping
+}
+
+// find returns the grouping for key; keys that cannot be map keys are bucketed with DeepEqualityComparer.
+func (l *Lookup) find(key interface{}) (*Grouping, bool) {
+	if isComparable(key) {
+		grouping, hasGrouping := l.index[key]
+		return grouping, hasGrouping
+	}
+	for _, grouping := range l.buckets[DeepEqualityComparer.HashCode(key)] {
+		if DeepEqualityComparer.Equals(grouping.key, key) {
+			return grouping, true
+		}
+	}
+	return nil, false
+}
+
+func (l *Lookup) add(key interface{}) *Grouping {
+	grouping := &Grouping{key: key, elements: &List{}}
+	if isComparable(key) {
+		l.index[key] = grouping
+	} else {
+		hashCode := DeepEqualityComparer.HashCode(key)
+		l.buckets[hashCode] = append(l.buckets[hashCode], grouping)
+	}
+	l.groupings = append(l.groupings, grouping)
+	return grouping
+}
+
+// Err returns the error that stopped reading the collection, if any; the lookup then only
+// holds the elements read before it.
+func (l *Lookup) Err() error {
+	return l.err
+}
+
+// Len returns the number of distinct keys.
+func (l *Lookup) Len() int {
+	return len(l.groupings)
+}
+
+func (l *Lookup) GetEnumerator() Enumerator {
+	return NewSliceEnumerator(l.groupings)
+}
+
+// --------------------------------------------------------------------------------
+// groupByEnumerable
+// --------------------------------------------------------------------------------
+
+type groupByEnumerable struct {
+	source          Enumerable
+	keySelector     KeySelector
+	elementSelector MapAction
+	resultSelector  GroupResultSelector
+}
+
+func (ge *groupByEnumerable) GetEnumerator() Enumerator {
+	lookup := newLookup(ge.source, ge.keySelector, ge.elementSelector)
+	if lookup.err != nil {
+		return &errorEnumerator{err: lookup.err}
+	}
+	if ge.resultSelector == nil {
+		return lookup.GetEnumerator()
+	}
+
+	return Map(lookup, func(value interface{}) interface{} {
+		grouping := value.(*Grouping)
+		return ge.resultSelector(grouping.key, grouping)
+	}).GetEnumerator()
+}
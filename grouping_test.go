@@ -0,0 +1,82 @@
+package collections
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func byFirstLetter(value interface{}) interface{} {
+	return value.(string)[0:1]
+}
+
+func TestGroupBy(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList("bar", "foo", "baz", "fizz", "buzz", "qux")
+
+	sourcePulls := 0
+	groups := GroupBy(Map(l, func(value interface{}) interface{} {
+		sourcePulls = sourcePulls + 1
+		return value
+	}), byFirstLetter)
+	a.Equal(0, sourcePulls)
+
+	grouped := ToList(groups).(*List)
+	a.Equal(3, grouped.Len())
+
+	b := grouped.At(0).(*Grouping)
+	a.Equal("b", b.Key())
+	a.Equal([]interface{}{"bar", "baz", "buzz"}, ToList(b).(*List).contents)
+
+	f := grouped.At(1).(*Grouping)
+	a.Equal("f", f.Key())
+	a.Equal([]interface{}{"foo", "fizz"}, ToList(f).(*List).contents)
+
+	q := grouped.At(2).(*Grouping)
+	a.Equal("q", q.Key())
+	a.Equal(1, q.Len())
+}
+
+func TestGroupBySelect(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList("bar", "foo", "baz", "fizz")
+
+	summaries := GroupBySelect(l, byFirstLetter, func(value interface{}) interface{} {
+		return strings.ToUpper(value.(string))
+	}, func(key interface{}, elements Enumerable) interface{} {
+		var names []string
+		for _, name := range ToList(elements).(*List).contents {
+			names = append(names, name.(string))
+		}
+		return key.(string) + ":" + strings.Join(names, ",")
+	})
+
+	a.Equal([]interface{}{"b:BAR,BAZ", "f:FOO,FIZZ"}, ToList(summaries).(*List).contents)
+}
+
+func TestToLookup(t *testing.T) {
+	a := assert.New(t)
+
+	lookup := ToLookup(NewList("bar", "foo", "baz"), byFirstLetter)
+	a.Equal(2, lookup.Len())
+	a.True(lookup.Contains("b"))
+	a.False(lookup.Contains("z"))
+
+	a.Equal([]interface{}{"bar", "baz"}, ToList(lookup.Get("b")).(*List).contents)
+	a.Equal(0, ToList(lookup.Get("z")).(*List).Len())
+
+	keys := ToList(Map(lookup, func(value interface{}) interface{} {
+		return value.(*Grouping).Key()
+	})).(*List)
+	a.Equal([]interface{}{"b", "f"}, keys.contents)
+
+	bySlice := ToLookup(NewList("a", "bb", "cc"), func(value interface{}) interface{} {
+		return []int{len(value.(string))}
+	})
+	a.Equal(2, bySlice.Len())
+	a.True(bySlice.Contains([]int{2}))
+	a.Equal([]interface{}{"bb", "cc"}, contentsOf(bySlice.Get([]int{2})))
+}
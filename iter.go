@@ -0,0 +1,184 @@
+package collections
+
+import "iter"
+
+// All returns an iterator over the elements of the collection, for use with range.
+// An error from the collection ends the iteration early; use AllWithError where it has to be reported.
+func All(collection Enumerable) iter.Seq[interface{}] {
+	values, _ := AllWithError(collection)
+	return values
+}
+
+// AllWithError is All, and also returns a function that returns the error, if any, that ended the last
+// iteration of the collection.
+func AllWithError(collection Enumerable) (iter.Seq[interface{}], func() error) {
+	var err error
+	values := func(yield func(interface{}) bool) {
+		e := collection.GetEnumerator()
+		defer closeEnumerator(e)
+		err = nil
+		for e.MoveNext() {
+			if !yield(e.GetCurrent()) {
+				return
+			}
+		}
+		err = enumeratorErr(e)
+	}
+	return values, func() error { return err }
+}
+
+// FromSeq returns an Enumerable over the values yielded by seq.
+// An enumerator that is abandoned before MoveNext returns false holds on to the
+// suspended iterator; call Close on it to release it.
+func FromSeq[V any](seq iter.Seq[V]) Enumerable {
+	return &seqEnumerable[V]{seq: seq}
+}
+
+// FromSeq2 returns an Enumerable over the key / value pairs yielded by seq as KeyValuePairs, like FromMap,
+// so ToMap(FromSeq2(maps.All(m)), &copied, nil, nil, DuplicateKeyError) copies m.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Enumerable {
+	return &seq2Enumerable[K, V]{seq: seq}
+}
+
+// Seq2 returns an iterator over the key / value pairs of the map, for use with range.
+// Each iteration starts from the beginning of the map.
+func (se *mapEnumerator) Seq2() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		se.Reset()
+		for se.MoveNext() {
+			if !yield(se.GetCurrentWithKey()) {
+				return
+			}
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------
+// seqEnumerable
+// --------------------------------------------------------------------------------
+
+type seqEnumerable[V any] struct {
+	seq iter.Seq[V]
+}
+
+func (se *seqEnumerable[V]) GetEnumerator() Enumerator {
+	return &seqEnumerator[V]{seq: se.seq}
+}
+
+type seqEnumerator[V any] struct {
+	seq     iter.Seq[V]
+	next    func() (V, bool)
+	stop    func()
+	done    bool
+	valid   bool
+	current V
+}
+
+func (se *seqEnumerator[V]) MoveNext() bool {
+	if se.done {
+		return false
+	}
+	if se.next == nil {
+		se.next, se.stop = iter.Pull(se.seq)
+	}
+
+	se.current, se.valid = se.next()
+	if !se.valid {
+		se.done = true
+		se.stop()
+	}
+	return se.valid
+}
+
+func (se *seqEnumerator[V]) GetCurrent() interface{} {
+	if !se.valid {
+		return nil
+	}
+	return se.current
+}
+
+// Close stops the iterator; MoveNext returns false until the enumerator is Reset.
+func (se *seqEnumerator[V]) Close() error {
+	if se.stop != nil {
+		se.stop()
+	}
+	se.done, se.valid = true, false
+	return nil
+}
+
+func (se *seqEnumerator[V]) Reset() {
+	if se.stop != nil {
+		se.stop()
+	}
+	se.next, se.stop = nil, nil
+	se.done, se.valid = false, false
+}
+
+// --------------------------------------------------------------------------------
+// seq2Enumerable
+// --------------------------------------------------------------------------------
+
+type seq2Enumerable[K, V any] struct {
+	seq iter.Seq2[K, V]
+}
+
+func (se *seq2Enumerable[K, V]) GetEnumerator() Enumerator {
+	return &seq2Enumerator[K, V]{seq: se.seq}
+}
+
+type seq2Enumerator[K, V any] struct {
+	seq          iter.Seq2[K, V]
+	next         func() (K, V, bool)
+	stop         func()
+	done         bool
+	valid        bool
+	currentKey   K
+	currentValue V
+}
+
+func (se *seq2Enumerator[K, V]) MoveNext() bool {
+	if se.done {
+		return false
+	}
+	if se.next == nil {
+		se.next, se.stop = iter.Pull2(se.seq)
+	}
+
+	se.currentKey, se.currentValue, se.valid = se.next()
+	if !se.valid {
+		se.done = true
+		se.stop()
+	}
+	return se.valid
+}
+
+func (se *seq2Enumerator[K, V]) GetCurrent() interface{} {
+	if !se.valid {
+		return nil
+	}
+	return KeyValuePair{Key: se.currentKey, Value: se.currentValue}
+}
+
+func (se *seq2Enumerator[K, V]) GetCurrentWithKey() (interface{}, interface{}) {
+	if !se.valid {
+		return nil, nil
+	}
+	return se.currentKey, se.currentValue
+}
+
+// Close stops the iterator; MoveNext returns false until the enumerator is Reset.
+func (se *seq2Enumerator[K, V]) Close() error {
+	if se.stop != nil {
+		se.stop()
+	}
+	se.done, se.valid = true, false
+	return nil
+}
+
+func (se *seq2Enumerator[K, V]) Reset() {
+	if se.stop != nil {
+		se.stop()
+	}
+	se.next, se.stop = nil, nil
+	se.done, se.valid = false, false
+}
@@ -0,0 +1,119 @@
+package collections
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/blendlabs/go-exception"
+)
+
+func TestAll(t *testing.T) {
+	a := assert.New(t)
+
+	var values []interface{}
+	for value := range All(NewList(1, 2, 3, 4)) {
+		if value.(int) > 3 {
+			break
+		}
+		values = append(values, value)
+	}
+	a.Equal([]interface{}{1, 2, 3}, values)
+}
+
+func TestAllWithError(t *testing.T) {
+	a := assert.New(t)
+
+	failure := exception.New("read failed")
+	all, err := AllWithError(&failingEnumerable{values: []interface{}{1, 2}, err: failure})
+	var values []interface{}
+	for value := range all {
+		values = append(values, value)
+	}
+	a.Equal([]interface{}{1, 2}, values)
+	a.Equal(failure, err())
+
+	all, err = AllWithError(NewList(1, 2))
+	for range all {
+	}
+	a.Nil(err())
+}
+
+func TestFromSeq(t *testing.T) {
+	a := assert.New(t)
+
+	fromSeq := FromSeq(slices.Values([]string{"foo", "bar", "baz"}))
+	a.Equal([]interface{}{"foo", "bar", "baz"}, ToList(fromSeq).(*List).contents)
+
+	e := fromSeq.GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal("foo", e.GetCurrent())
+	e.Reset()
+	a.True(e.MoveNext())
+	a.Equal("foo", e.GetCurrent())
+	a.True(e.MoveNext())
+	a.True(e.MoveNext())
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+}
+
+func TestFromSeq2(t *testing.T) {
+	a := assert.New(t)
+
+	e := FromSeq2(slices.All([]string{"foo", "bar"})).GetEnumerator().(*seq2Enumerator[int, string])
+	a.True(e.MoveNext())
+	key, value := e.GetCurrentWithKey()
+	a.Equal(0, key)
+	a.Equal("foo", value)
+	a.Equal(KeyValuePair{Key: 0, Value: "foo"}, e.GetCurrent())
+
+	a.True(e.MoveNext())
+	key, value = e.GetCurrentWithKey()
+	a.Equal(1, key)
+	a.Equal("bar", value)
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+
+	myMap := map[string]int{"foo": 1, "bar": 2}
+	var copied map[string]int
+	a.Nil(ToMap(FromSeq2(maps.All(myMap)), &copied, nil, nil, DuplicateKeyError))
+	a.Equal(myMap, copied)
+	a.Equal([]interface{}{"bar", "foo"}, contentsOf(SortBy(Keys(FromSeq2(maps.All(myMap))), DefaultKeySelector)))
+	a.Equal([]interface{}{1, 2}, contentsOf(SortBy(Values(FromSeq2(maps.All(myMap))), DefaultKeySelector)))
+}
+
+func TestMapEnumeratorSeq2(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[string]string{"foo": "foo_value", "bar": "bar_value", "baz": "baz_value"}
+	me := NewMapEnumerator(myMap)
+
+	collected := map[string]string{}
+	for key, value := range me.Seq2() {
+		collected[key.(string)] = value.(string)
+	}
+	a.True(maps.Equal(myMap, collected))
+
+	count := 0
+	for range me.Seq2() {
+		count = count + 1
+	}
+	a.Equal(3, count)
+}
+
+func TestFromSeqClose(t *testing.T) {
+	a := assert.New(t)
+
+	released := false
+	naturals := FromSeq(func(yield func(int) bool) {
+		defer func() { released = true }()
+		for value := 0; yield(value); value++ {
+		}
+	})
+
+	first, err := First(naturals, func(value interface{}) bool { return value.(int) > 2 })
+	a.Nil(err)
+	a.Equal(3, first)
+	a.True(released)
+}
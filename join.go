@@ -0,0 +1,149 @@
+package collections
+
+// JoinResultSelector projects an outer element and a matching inner element into a result.
+type JoinResultSelector func(outer, inner interface{}) interface{}
+
+// GroupJoinResultSelector projects an outer element and all of its matching inner elements into a result.
+type GroupJoinResultSelector func(outer interface{}, inners Enumerable) interface{}
+
+// Join returns a lazy enumerable of resultSelector applied to each pair of outer and inner elements
+// with equal keys, in outer order and then inner order. The inner collection is hashed by key when
+// the result is enumerated; keys are compared as for GroupBy.
+func Join(outer, inner Enumerable, outerKey, innerKey KeySelector, resultSelector JoinResultSelector) Enumerable {
+	return &joinEnumerable{
+		outer:          outer,
+		inner:          inner,
+		outerKey:       outerKey,
+		innerKey:       innerKey,
+		resultSelector: resultSelector,
+	}
+}
+
+// LeftJoin is Join, except that outer elements without a matching inner element are yielded once
+// with a nil inner element.
+func LeftJoin(outer, inner Enumerable, outerKey, innerKey KeySelector, resultSelector JoinResultSelector) Enumerable {
+	return &joinEnumerable{
+		outer:          outer,
+		inner:          inner,
+		outerKey:       outerKey,
+		innerKey:       innerKey,
+		resultSelector: resultSelector,
+		leftOuter:      true,
+	}
+}
+
+// GroupJoin returns a lazy enumerable of resultSelector applied to each outer element and the
+// (possibly empty) inner elements with an equal key.
+func GroupJoin(outer, inner Enumerable, outerKey, innerKey KeySelector, resultSelector GroupJoinResultSelector) Enumerable {
+	return &groupJoinEnumerable{
+		outer:          outer,
+		inner:          inner,
+		outerKey:       outerKey,
+		innerKey:       innerKey,
+		resultSelector: resultSelector,
+	}
+}
+
+// --------------------------------------------------------------------------------
+// joinEnumerable
+// --------------------------------------------------------------------------------
+
+type joinEnumerable struct {
+	outer          Enumerable
+	inner          Enumerable
+	outerKey       KeySelector
+	innerKey       KeySelector
+	resultSelector JoinResultSelector
+	leftOuter      bool
+}
+
+func (je *joinEnumerable) GetEnumerator() Enumerator {
+	lookup := ToLookup(je.inner, je.innerKey)
+	if lookup.err != nil {
+		return &errorEnumerator{err: lookup.err}
+	}
+	return &joinEnumerator{
+		outer:          je.outer.GetEnumerator(),
+		outerKey:       je.outerKey,
+		lookup:         lookup,
+		resultSelector: je.resultSelector,
+		leftOuter:      je.leftOuter,
+	}
+}
+
+type joinEnumerator struct {
+	outer          Enumerator
+	outerKey       KeySelector
+	lookup         *Lookup
+	resultSelector JoinResultSelector
+	leftOuter      bool
+
+	currentOuter interface{}
+	matches      Enumerator
+	current      interface{}
+}
+
+func (je *joinEnumerator) MoveNext() bool {
+	for {
+		if je.matches != nil && je.matches.MoveNext() {
+			je.current = je.resultSelector(je.currentOuter, je.matches.GetCurrent())
+			return true
+		}
+
+		if !je.outer.MoveNext() {
+			je.currentOuter, je.matches, je.current = nil, nil, nil
+			return false
+		}
+
+		je.currentOuter = je.outer.GetCurrent()
+		key := je.outerKey(je.currentOuter)
+		if je.lookup.Contains(key) {
+			je.matches = je.lookup.Get(key).GetEnumerator()
+		} else {
+			je.matches = nil
+			if je.leftOuter {
+				je.current = je.resultSelector(je.currentOuter, nil)
+				return true
+			}
+		}
+	}
+}
+
+func (je *joinEnumerator) GetCurrent() interface{} {
+	return je.current
+}
+
+func (je *joinEnumerator) Err() error {
+	return enumeratorErr(je.outer)
+}
+
+func (je *joinEnumerator) Close() error {
+	return closeEnumerator(je.outer)
+}
+
+func (je *joinEnumerator) Reset() {
+	je.outer.Reset()
+	je.currentOuter, je.matches, je.current = nil, nil, nil
+}
+
+// --------------------------------------------------------------------------------
+// groupJoinEnumerable
+// --------------------------------------------------------------------------------
+
+type groupJoinEnumerable struct {
+	outer          Enumerable
+	inner          Enumerable
+	outerKey       KeySelector
+	innerKey       KeySelector
+	resultSelector GroupJoinResultSelector
+}
+
+func (ge *groupJoinEnumerable) GetEnumerator() Enumerator {
+	lookup := ToLookup(ge.inner, ge.innerKey)
+	if lookup.err != nil {
+		return &errorEnumerator{err: lookup.err}
+	}
+	return Map(ge.outer, func(value interface{}) interface{} {
+		return ge.resultSelector(value, lookup.Get(ge.outerKey(value)))
+	}).GetEnumerator()
+}
@@ -0,0 +1,89 @@
+package collections
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+type customer struct {
+	Id   int
+	Name string
+}
+
+type order struct {
+	Id         int
+	CustomerId int
+	Total      int
+}
+
+func customers() Enumerable {
+	return NewList(customer{1, "Alice"}, customer{2, "Bob"}, customer{3, "Carol"})
+}
+
+func orders() Enumerable {
+	return NewList(order{10, 2, 5}, order{11, 1, 7}, order{12, 2, 9}, order{13, 4, 1})
+}
+
+func customerId(value interface{}) interface{} {
+	return value.(customer).Id
+}
+
+func orderCustomerId(value interface{}) interface{} {
+	return value.(order).CustomerId
+}
+
+func TestJoin(t *testing.T) {
+	a := assert.New(t)
+
+	joined := Join(customers(), orders(), customerId, orderCustomerId, func(outer, inner interface{}) interface{} {
+		return outer.(customer).Name + ":" + strconv.Itoa(inner.(order).Id)
+	})
+
+	a.Equal([]interface{}{"Alice:11", "Bob:10", "Bob:12"}, ToList(joined).(*List).contents)
+
+	e := joined.GetEnumerator()
+	a.True(e.MoveNext())
+	e.Reset()
+	var replayed []interface{}
+	for e.MoveNext() {
+		replayed = append(replayed, e.GetCurrent())
+	}
+	a.Equal([]interface{}{"Alice:11", "Bob:10", "Bob:12"}, replayed)
+
+	sliceKey := func(value interface{}) interface{} {
+		return []int{value.(int) % 2}
+	}
+	pairs := Join(NewList(1, 2), NewList(3, 5, 4), sliceKey, sliceKey, func(outer, inner interface{}) interface{} {
+		return Pair{outer, inner}
+	})
+	a.Equal([]interface{}{Pair{1, 3}, Pair{1, 5}, Pair{2, 4}}, contentsOf(pairs))
+}
+
+func TestLeftJoin(t *testing.T) {
+	a := assert.New(t)
+
+	joined := LeftJoin(orders(), customers(), orderCustomerId, customerId, func(outer, inner interface{}) interface{} {
+		if inner == nil {
+			return "unknown"
+		}
+		return inner.(customer).Name
+	})
+
+	a.Equal([]interface{}{"Bob", "Alice", "Bob", "unknown"}, ToList(joined).(*List).contents)
+}
+
+func TestGroupJoin(t *testing.T) {
+	a := assert.New(t)
+
+	totals := GroupJoin(customers(), orders(), customerId, orderCustomerId, func(outer interface{}, inners Enumerable) interface{} {
+		total := 0
+		for _, value := range ToList(inners).(*List).contents {
+			total = total + value.(order).Total
+		}
+		return total
+	})
+
+	a.Equal([]interface{}{7, 14, 0}, ToList(totals).(*List).contents)
+}
@@ -2,6 +2,14 @@ package collections
 
 import "sort"
 
+// OrderedEnumerable is an Enumerable sorted by one or more keys; further keys break ties
+// between elements that are equal on the keys before them.
+type OrderedEnumerable interface {
+	Enumerable
+	ThenBy(sortKey KeySelector) OrderedEnumerable
+	ThenByDescending(sortKey KeySelector) OrderedEnumerable
+}
+
 // KeySelector returns a field from a given value
 type KeySelector func(value interface{}) interface{}
 
@@ -15,112 +23,249 @@ func DefaultKeySelector(value interface{}) interface{} {
 	return value
 }
 
+// Map returns a lazy enumerable that projects each element with mapFn as it is enumerated.
 func Map(collection Enumerable, mapFn MapAction) Enumerable {
-	newList := &List{}
-	e := collection.GetEnumerator()
-	hasNext := true
-	for hasNext {
-		newList.Add(mapFn(e.GetCurrent()))
-		hasNext = e.MoveNext()
-	}
-	return newList
+	return &selectEnumerable{source: collection, mapFn: mapFn}
 }
 
-func (e Enumerable) Select(mapFn MapAction) Enumerable {
-	return Map(e, mapFn)
+// Filter returns a lazy enumerable of the elements that match the predicate.
+func Filter(collection Enumerable, predicate Predicate) Enumerable {
+	return &whereEnumerable{source: collection, predicate: predicate}
 }
 
-func Filter(collection Enumerable, predicate Predicate) Enumerable {
+// SortBy returns the elements of the collection ordered by the keys returned by sortKey.
+// The comparer is resolved from the type of the keys, with numeric keys of mixed types compared exactly,
+// and elements with equal keys keep their input order.
+// The collection is sorted each time the result is enumerated.
+func SortBy(collection Enumerable, sortKey KeySelector) OrderedEnumerable {
+	return &orderedEnumerable{source: collection, sortKeys: []orderingKey{{selector: sortKey}}}
+}
+
+// SortByDescending is SortBy with the keys in descending order.
+func SortByDescending(collection Enumerable, sortKey KeySelector) OrderedEnumerable {
+	return &orderedEnumerable{source: collection, sortKeys: []orderingKey{{selector: sortKey, descending: true}}}
+}
+
+// Peek returns the first element of the collection, or nil if it is empty.
+func Peek(collection Enumerable) (interface{}, error) {
+	return FirstOrDefault(collection, nil, nil)
+}
+
+// PeekBack returns the last element of the collection, or nil if it is empty.
+func PeekBack(collection Enumerable) (interface{}, error) {
+	return LastOrDefault(collection, nil, nil)
+}
+
+// ToList copies the collection into a *List. The result is always a *List: if the collection fails it holds the
+// elements read before the error, which is dropped; use Collect where the error has to be reported.
+func ToList(collection Enumerable) Enumerable {
+	newList, _ := Collect(collection)
+	return newList
+}
+
+// Collect is ToList for collections that can fail: it also returns the error that stopped the enumeration,
+// or else the error from closing the enumerator, in which case the list holds the elements read before it.
+func Collect(collection Enumerable) (*List, error) {
+	if typedCollection, isList := collection.(*List); isList {
+		return typedCollection, nil
+	}
+
 	newList := &List{}
+	err := ForEach(collection, func(value interface{}) bool {
+		newList.Add(value)
+		return true
+	})
+	return newList, err
+}
+
+// ForEach calls action on each element of the collection until it returns false, and then closes the enumerator.
+// It returns the error that stopped the enumeration, if any, or else the error from closing the enumerator.
+func ForEach(collection Enumerable, action func(value interface{}) bool) error {
 	e := collection.GetEnumerator()
-	hasNext := true
-	for hasNext {
-		current := e.GetCurrent()
-		if predicate(current) {
-			newList.Add(current)
+	for e.MoveNext() {
+		if !action(e.GetCurrent()) {
+			break
 		}
-		hasNext = e.MoveNext()
 	}
-	return newList
-}
 
-func SortBy(collection Enumerable, sortKey KeySelector) Enumerable {
-	collectionAsList := ToList(collection).(*List)
-	comparer, comparerError := getComparer(collectionAsList.contents)
-	if comparerError != nil {
-		println(comparerError.Error())
+	err := enumeratorErr(e)
+	if closeErr := closeEnumerator(e); err == nil {
+		err = closeErr
 	}
+	return err
+}
+
+// --------------------------------------------------------------------------------
+// internal Types
+// --------------------------------------------------------------------------------
 
-	sort.Sort(newSortableList(collectionAsList, comparer, false))
+type selectEnumerable struct {
+	source Enumerable
+	mapFn  MapAction
+}
+
+func (se *selectEnumerable) GetEnumerator() Enumerator {
+	return &selectEnumerator{source: se.source.GetEnumerator(), mapFn: se.mapFn}
+}
+
+type selectEnumerator struct {
+	source     Enumerator
+	mapFn      MapAction
+	valid      bool
+	current    interface{}
+	hasCurrent bool
+}
 
-	return collectionAsList
+func (se *selectEnumerator) MoveNext() bool {
+	se.current, se.hasCurrent = nil, false
+	se.valid = se.source.MoveNext()
+	return se.valid
 }
 
-func SortByDescending(collection Enumerable, sortKey KeySelector) Enumerable {
-	collectionAsList := ToList(collection).(*List)
-	comparer, comparerError := getComparer(collectionAsList.contents)
-	if comparerError != nil {
-		println(comparerError.Error())
+func (se *selectEnumerator) GetCurrent() interface{} {
+	if !se.valid {
+		return nil
+	}
+	if !se.hasCurrent {
+		se.current, se.hasCurrent = se.mapFn(se.source.GetCurrent()), true
 	}
+	return se.current
+}
+
+func (se *selectEnumerator) Err() error {
+	return enumeratorErr(se.source)
+}
 
-	sort.Sort(newSortableList(collectionAsList, comparer, true))
+func (se *selectEnumerator) Close() error {
+	return closeEnumerator(se.source)
+}
 
-	return collectionAsList
+func (se *selectEnumerator) Reset() {
+	se.valid, se.current, se.hasCurrent = false, nil, false
+	se.source.Reset()
 }
 
-func Peek(collection Enumerable) interface{} {
-	e := collection.GetEnumerator()
-	return e.GetCurrent()
+type whereEnumerable struct {
+	source    Enumerable
+	predicate Predicate
 }
 
-func PeekBack(collection Enumerable) interface{} {
-	asList := ToList(collection).(*List)
-	return asList.Last()
+func (we *whereEnumerable) GetEnumerator() Enumerator {
+	return &whereEnumerator{source: we.source.GetEnumerator(), predicate: we.predicate}
 }
 
-func First(collection Enumerable, predicate Predicate) interface{} {
-	e := collection.GetEnumerator()
-	hasNext := true
-	for hasNext {
-		current := e.GetCurrent()
-		if predicate(current) {
-			return current
+type whereEnumerator struct {
+	source    Enumerator
+	predicate Predicate
+}
+
+func (we *whereEnumerator) MoveNext() bool {
+	for we.source.MoveNext() {
+		if we.predicate(we.source.GetCurrent()) {
+			return true
 		}
-		hasNext = e.MoveNext()
 	}
-	return nil
+	return false
 }
 
-func ToList(collection Enumerable) Enumerable {
-	if typedCollection, isList := collection.(*List); isList {
-		return typedCollection
-	}
+func (we *whereEnumerator) GetCurrent() interface{} {
+	return we.source.GetCurrent()
+}
 
-	newList := &List{}
-	e := collection.GetEnumerator()
-	hasNext := true
-	for hasNext {
-		newList.Add(e.GetCurrent())
+func (we *whereEnumerator) Err() error {
+	return enumeratorErr(we.source)
+}
+
+func (we *whereEnumerator) Close() error {
+	return closeEnumerator(we.source)
+}
+
+func (we *whereEnumerator) Reset() {
+	we.source.Reset()
+}
+
+type orderingKey struct {
+	selector   KeySelector
+	descending bool
+}
+
+type orderedEnumerable struct {
+	source   Enumerable
+	sortKeys []orderingKey
+}
+
+func (oe *orderedEnumerable) ThenBy(selector KeySelector) OrderedEnumerable {
+	return oe.thenBy(orderingKey{selector: selector})
+}
+
+func (oe *orderedEnumerable) ThenByDescending(selector KeySelector) OrderedEnumerable {
+	return oe.thenBy(orderingKey{selector: selector, descending: true})
+}
+
+func (oe *orderedEnumerable) thenBy(key orderingKey) OrderedEnumerable {
+	sortKeys := make([]orderingKey, len(oe.sortKeys), len(oe.sortKeys)+1)
+	copy(sortKeys, oe.sortKeys)
+	return &orderedEnumerable{source: oe.source, sortKeys: append(sortKeys, key)}
+}
+
+func (oe *orderedEnumerable) GetEnumerator() Enumerator {
+	sorted, err := oe.sort()
+	if err != nil {
+		return &errorEnumerator{err: err}
 	}
-	return newList
+	return sorted.GetEnumerator()
 }
 
-// --------------------------------------------------------------------------------
-// internal Types
-// --------------------------------------------------------------------------------
+func (oe *orderedEnumerable) sort() (*List, error) {
+	source, err := Collect(oe.source)
+	if err != nil {
+		return nil, err
+	}
+	sorted := &List{contents: append([]interface{}{}, source.contents...)}
+
+	keys := make([][]interface{}, len(oe.sortKeys))
+	comparers := make([]Comparer, len(oe.sortKeys))
+	descending := make([]bool, len(oe.sortKeys))
+	for level, key := range oe.sortKeys {
+		keys[level] = make([]interface{}, sorted.Len())
+		for index, value := range sorted.contents {
+			keys[level][index] = key.selector(value)
+		}
+
+		comparer, comparerError := getComparerForValues(keys[level])
+		if comparerError != nil {
+			return nil, comparerError
+		}
+		comparers[level] = comparer
+		descending[level] = key.descending
+	}
+
+	sortable := newSortableList(sorted, keys, comparers, descending)
+	sort.Stable(sortable)
+	if sortable.err != nil {
+		return nil, sortable.err
+	}
+	return sorted, nil
+}
 
-func newSortableList(contents *List, comparer Comparer, descending bool) *sortableList {
+func newSortableList(contents *List, keys [][]interface{}, comparers []Comparer, descending []bool) *sortableList {
 	return &sortableList{
 		contents:   contents,
-		comparer:   comparer,
+		keys:       keys,
+		comparers:  comparers,
 		descending: descending,
 	}
 }
 
+// sortableList sorts a list by precomputed keys for each element; keys[level][index] is
+// compared with comparers[level], and later levels are only consulted when earlier ones are equal.
+// The first error from a comparer is kept in err.
 type sortableList struct {
 	contents   *List
-	comparer   Comparer
-	descending bool
+	keys       [][]interface{}
+	comparers  []Comparer
+	descending []bool
+	err        error
 }
 
 func (s *sortableList) Len() int {
@@ -129,20 +274,140 @@ func (s *sortableList) Len() int {
 
 func (s *sortableList) Swap(i, j int) {
 	s.contents.contents[i], s.contents.contents[j] = s.contents.contents[j], s.contents.contents[i]
+	for _, keys := range s.keys {
+		keys[i], keys[j] = keys[j], keys[i]
+	}
 }
 
 func (s *sortableList) Less(i, j int) bool {
-	iValue := s.contents.At(i)
-	jValue := s.contents.At(j)
+	for level, comparer := range s.comparers {
+		compareResult, compareErr := compareNilFirst(comparer, s.keys[level][i], s.keys[level][j])
+		if compareErr != nil && s.err == nil {
+			s.err = compareErr
+		}
 
-	compareResult, compareErr := s.comparer(iValue, jValue)
-	if compareErr != nil {
-		println(compareErr.Error())
+		if compareResult == 0 {
+			continue
+		}
+		if s.descending[level] {
+			return compareResult > 0
+		} else {
+			return compareResult < 0
+		}
 	}
+	return false
+}
 
-	if s.descending {
-		return compareResult == 1
-	} else {
-		return compareResult == -1
+type concatEnumerable struct {
+	sources []Enumerable
+}
+
+func (ce *concatEnumerable) GetEnumerator() Enumerator {
+	return &concatEnumerator{sources: ce.sources, index: -1}
+}
+
+type concatEnumerator struct {
+	sources []Enumerable
+	index   int
+	current Enumerator
+	err     error
+}
+
+func (ce *concatEnumerator) MoveNext() bool {
+	if ce.err != nil {
+		return false
 	}
+	for {
+		if ce.current != nil {
+			if ce.current.MoveNext() {
+				return true
+			}
+			ce.err = enumeratorErr(ce.current)
+			if closeErr := closeEnumerator(ce.current); ce.err == nil {
+				ce.err = closeErr
+			}
+			if ce.err != nil {
+				ce.current = nil
+				return false
+			}
+		}
+		if ce.index+1 >= len(ce.sources) {
+			ce.index, ce.current = len(ce.sources), nil
+			return false
+		}
+		ce.index = ce.index + 1
+		ce.current = ce.sources[ce.index].GetEnumerator()
+	}
+}
+
+func (ce *concatEnumerator) GetCurrent() interface{} {
+	if ce.current == nil {
+		return nil
+	}
+	return ce.current.GetCurrent()
+}
+
+func (ce *concatEnumerator) Err() error {
+	return ce.err
+}
+
+// Close closes the enumerator of the current collection; those before it were closed when they ended.
+func (ce *concatEnumerator) Close() error {
+	if ce.current == nil {
+		return nil
+	}
+	return closeEnumerator(ce.current)
+}
+
+func (ce *concatEnumerator) Reset() {
+	ce.Close()
+	ce.index, ce.current, ce.err = -1, nil, nil
+}
+
+// deferredEnumerable calls build for a fresh enumerator each time it is enumerated or Reset, for
+// operators that keep state (like a set of seen values) that has to start over with the enumeration.
+type deferredEnumerable struct {
+	build func() Enumerator
+}
+
+func (de *deferredEnumerable) GetEnumerator() Enumerator {
+	return &deferredEnumerator{build: de.build}
+}
+
+type deferredEnumerator struct {
+	build   func() Enumerator
+	current Enumerator
+}
+
+func (de *deferredEnumerator) MoveNext() bool {
+	if de.current == nil {
+		de.current = de.build()
+	}
+	return de.current.MoveNext()
+}
+
+func (de *deferredEnumerator) GetCurrent() interface{} {
+	if de.current == nil {
+		return nil
+	}
+	return de.current.GetCurrent()
+}
+
+func (de *deferredEnumerator) Err() error {
+	if de.current == nil {
+		return nil
+	}
+	return enumeratorErr(de.current)
+}
+
+func (de *deferredEnumerator) Close() error {
+	if de.current == nil {
+		return nil
+	}
+	return closeEnumerator(de.current)
+}
+
+func (de *deferredEnumerator) Reset() {
+	de.Close()
+	de.current = nil
 }
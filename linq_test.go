@@ -10,9 +10,9 @@ func TestLinqMap(t *testing.T) {
 	a := assert.New(t)
 
 	l := NewList(1, 2, 3, 4)
-	mapped := Map(l, func(value interface{}) interface{} {
+	mapped := ToList(Map(l, func(value interface{}) interface{} {
 		return (value.(int)) * 2
-	}).(*List)
+	})).(*List)
 
 	a.Equal(2, mapped.At(0))
 	a.Equal(4, mapped.At(1))
@@ -24,20 +24,107 @@ func TestLinqFilter(t *testing.T) {
 	a := assert.New(t)
 
 	l := NewList(1, 2, 3, 4)
-	filtered := Filter(l, func(value interface{}) bool {
+	filtered := ToList(Filter(l, func(value interface{}) bool {
 		return value.(int) < 3
-	}).(*List)
+	})).(*List)
 
 	a.Equal(2, filtered.Len())
 	a.Equal(1, filtered.At(0))
 	a.Equal(2, filtered.At(1))
 }
 
+// countingEnumerable is an infinite source of the integers starting at zero.
+type countingEnumerable struct {
+	pulled int
+}
+
+func (ce *countingEnumerable) GetEnumerator() Enumerator {
+	return &countingEnumerator{parent: ce, current: -1}
+}
+
+type countingEnumerator struct {
+	parent  *countingEnumerable
+	current int
+}
+
+func (ce *countingEnumerator) MoveNext() bool {
+	ce.current = ce.current + 1
+	ce.parent.pulled = ce.parent.pulled + 1
+	return true
+}
+
+func (ce *countingEnumerator) GetCurrent() interface{} {
+	return ce.current
+}
+
+func (ce *countingEnumerator) Reset() {
+	ce.current = -1
+}
+
+func TestLinqLazyPipeline(t *testing.T) {
+	a := assert.New(t)
+
+	source := &countingEnumerable{}
+	mapCalls := 0
+	doubled := Map(source, func(value interface{}) interface{} {
+		mapCalls = mapCalls + 1
+		return value.(int) * 2
+	})
+	a.Equal(0, mapCalls)
+
+	overTen := Filter(doubled, func(value interface{}) bool {
+		return value.(int) > 10
+	})
+	a.Equal(0, mapCalls)
+
+	first, err := First(overTen, func(value interface{}) bool {
+		return value.(int)%4 == 0
+	})
+	a.Nil(err)
+	a.Equal(12, first)
+	a.Equal(7, source.pulled)
+	a.Equal(7, mapCalls)
+}
+
+func TestLinqFilterReset(t *testing.T) {
+	a := assert.New(t)
+
+	e := Filter(NewList(1, 2, 3, 4, 5), func(value interface{}) bool {
+		return value.(int)%2 == 0
+	}).GetEnumerator()
+
+	a.True(e.MoveNext())
+	a.Equal(2, e.GetCurrent())
+	a.True(e.MoveNext())
+	a.Equal(4, e.GetCurrent())
+	a.False(e.MoveNext())
+	a.Nil(e.GetCurrent())
+
+	e.Reset()
+	a.True(e.MoveNext())
+	a.Equal(2, e.GetCurrent())
+}
+
+func TestLinqEmpty(t *testing.T) {
+	a := assert.New(t)
+
+	empty := NewList()
+	a.Equal(0, ToList(Map(empty, DefaultKeySelector)).(*List).Len())
+	first, err := Peek(empty)
+	a.Nil(err)
+	a.Nil(first)
+	last, err := PeekBack(empty)
+	a.Nil(err)
+	a.Nil(last)
+	_, err = First(empty, nil)
+	a.Equal(ErrNoElements, err)
+}
+
 func TestLinqSortInts(t *testing.T) {
 	a := assert.New(t)
 	ints := NewList(7, 5, 3, 8, 2, 1, 4, 6)
 
-	sorted := SortBy(ints, DefaultKeySelector).(*List)
+	sorted := ToList(SortBy(ints, DefaultKeySelector)).(*List)
 
 	a.Equal(1, sorted.At(0))
 	a.Equal(2, sorted.At(1))
@@ -53,7 +140,7 @@ func TestLinqSortDescendingInts(t *testing.T) {
 	a := assert.New(t)
 	ints := NewList(7, 5, 3, 8, 2, 1, 4, 6)
 
-	sorted := SortByDescending(ints, DefaultKeySelector).(*List)
+	sorted := ToList(SortByDescending(ints, DefaultKeySelector)).(*List)
 
 	a.Equal(8, sorted.At(0))
 	a.Equal(7, sorted.At(1))
@@ -73,13 +160,163 @@ type myTestType struct {
 func TestLinqSortStructs(t *testing.T) {
 	a := assert.New(t)
 
-	l := NewList(myTestType{Id: 1, Name: "Foo"}, myTestType{Id: 2, Name: "Bar"}, myTestType{Id: 3, Name: "Baz"})
+	l := NewList(myTestType{Id: 3, Name: "Foo"}, myTestType{Id: 1, Name: "Bar"}, myTestType{Id: 2, Name: "Baz"})
 
-	sorted := SortBy(l, func(v interface{}) interface{} {
+	selectorCalls := 0
+	sorted := ToList(SortBy(l, func(v interface{}) interface{} {
+		selectorCalls = selectorCalls + 1
 		return (v.(myTestType)).Id
-	}).(*List)
+	})).(*List)
 
-	a.Equal(3, sorted.At(0))
-	a.Equal(2, sorted.At(1))
-	a.Equal(1, sorted.At(2))
+	a.Equal(3, selectorCalls)
+	a.Equal(1, sorted.At(0).(myTestType).Id)
+	a.Equal(2, sorted.At(1).(myTestType).Id)
+	a.Equal(3, sorted.At(2).(myTestType).Id)
+
+	a.Equal(3, l.At(0).(myTestType).Id, "the source should not be reordered")
+
+	byName := ToList(SortByDescending(l, func(v interface{}) interface{} {
+		return (v.(myTestType)).Name
+	})).(*List)
+
+	a.Equal("Foo", byName.At(0).(myTestType).Name)
+	a.Equal("Baz", byName.At(1).(myTestType).Name)
+	a.Equal("Bar", byName.At(2).(myTestType).Name)
+}
+
+func TestLinqSortIsStable(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(
+		myTestType{Id: 2, Name: "a"},
+		myTestType{Id: 1, Name: "b"},
+		myTestType{Id: 2, Name: "c"},
+		myTestType{Id: 1, Name: "d"},
+		myTestType{Id: 2, Name: "e"},
+	)
+	byId := func(v interface{}) interface{} {
+		return (v.(myTestType)).Id
+	}
+	names := func(e Enumerable) []interface{} {
+		return ToList(Map(e, func(v interface{}) interface{} {
+			return (v.(myTestType)).Name
+		})).(*List).contents
+	}
+
+	a.Equal([]interface{}{"b", "d", "a", "c", "e"}, names(SortBy(l, byId)))
+	a.Equal([]interface{}{"a", "c", "e", "b", "d"}, names(SortByDescending(l, byId)))
+}
+
+type version struct {
+	Major, Minor int
+}
+
+func (v version) CompareTo(other interface{}) (int, error) {
+	otherVersion := other.(version)
+	if v.Major != otherVersion.Major {
+		return v.Major - otherVersion.Major, nil
+	}
+	return v.Minor - otherVersion.Minor, nil
+}
+
+func TestLinqSortComparableKeys(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(version{2, 0}, version{1, 10}, version{1, 2})
+	sorted := ToList(SortBy(l, DefaultKeySelector)).(*List)
+
+	a.Equal([]interface{}{version{1, 2}, version{1, 10}, version{2, 0}}, sorted.contents)
+}
+
+func TestLinqSortNilKeysFirst(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList("b", nil, "a")
+	sorted := ToList(SortBy(l, DefaultKeySelector)).(*List)
+
+	a.Equal([]interface{}{nil, "a", "b"}, sorted.contents)
+}
+
+type employee struct {
+	Department string
+	Salary     int
+	Name       string
+}
+
+func TestLinqThenBy(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(
+		employee{"Sales", 100, "Carol"},
+		employee{"Engineering", 200, "Bob"},
+		employee{"Sales", 150, "Alice"},
+		employee{"Engineering", 200, "Alice"},
+		employee{"Engineering", 300, "Dave"},
+		employee{"Sales", 100, "Bob"},
+	)
+
+	sorted := SortBy(l, func(v interface{}) interface{} {
+		return v.(employee).Department
+	}).ThenByDescending(func(v interface{}) interface{} {
+		return v.(employee).Salary
+	}).ThenBy(func(v interface{}) interface{} {
+		return v.(employee).Name
+	})
+
+	a.Equal([]interface{}{
+		employee{"Engineering", 300, "Dave"},
+		employee{"Engineering", 200, "Alice"},
+		employee{"Engineering", 200, "Bob"},
+		employee{"Sales", 150, "Alice"},
+		employee{"Sales", 100, "Bob"},
+		employee{"Sales", 100, "Carol"},
+	}, ToList(sorted).(*List).contents)
+}
+
+func TestLinqThenByDoesNotModifyParent(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(employee{"Sales", 100, "Bob"}, employee{"Sales", 100, "Alice"})
+	byDepartment := SortBy(l, func(v interface{}) interface{} {
+		return v.(employee).Department
+	})
+	byDepartment.ThenBy(func(v interface{}) interface{} {
+		return v.(employee).Name
+	})
+
+	first, err := Peek(byDepartment)
+	a.Nil(err)
+	a.Equal("Bob", first.(employee).Name)
+}
+
+func TestLinqCollect(t *testing.T) {
+	a := assert.New(t)
+
+	values, err := Collect(Map(NewList(1, 2, 3), func(value interface{}) interface{} { return value.(int) + 1 }))
+	a.Nil(err)
+	a.Equal([]interface{}{2, 3, 4}, values.contents)
+}
+
+func TestLinqSortReportsComparerErrors(t *testing.T) {
+	a := assert.New(t)
+
+	e := SortBy(NewList("a", 1, "b"), DefaultKeySelector).GetEnumerator()
+	a.False(e.MoveNext())
+	a.NotNil(e.(ErrEnumerator).Err())
+}
+
+func TestLinqSortMixedNumericKeys(t *testing.T) {
+	a := assert.New(t)
+
+	sorted, err := Collect(SortBy(NewList(2, 2.5, 1.5, 1), DefaultKeySelector))
+	a.Nil(err)
+	a.Equal([]interface{}{1, 1.5, 2, 2.5}, sorted.contents)
+
+	sorted, err = Collect(SortByDescending(NewList(uint8(3), -1, int64(300)), DefaultKeySelector))
+	a.Nil(err)
+	a.Equal([]interface{}{int64(300), uint8(3), -1}, sorted.contents)
+
+	sorted, err = Collect(SortBy(NewList(int64(1<<53+1), 0.5, float64(1<<53)), DefaultKeySelector))
+	a.Nil(err)
+	a.Equal([]interface{}{0.5, float64(1 << 53), int64(1<<53 + 1)}, sorted.contents)
 }
@@ -2,6 +2,7 @@ package collections
 
 import (
 	"reflect"
+	"sort"
 
 	"github.com/blendlabs/go-exception"
 )
@@ -53,7 +54,7 @@ func (l *List) Len() int {
 }
 
 func (l *List) RemoveAt(index int) error {
-	if index >= l.Len() {
+	if index < 0 || index >= l.Len() {
 		return exception.Newf("Invalid index for RemoveAt(%d)", index)
 	}
 
@@ -67,6 +68,185 @@ func (l *List) RemoveAt(index int) error {
 	return nil
 }
 
+// Insert inserts item at index, which may be Len() to add it at the end.
+func (l *List) Insert(index int, item interface{}) error {
+	if index < 0 || index > l.Len() {
+		return exception.Newf("Invalid index for Insert(%d)", index)
+	}
+
+	l.contents = append(l.contents, nil)
+	copy(l.contents[index+1:], l.contents[index:])
+	l.contents[index] = item
+	return nil
+}
+
+// InsertRange inserts the elements of the collection at index, which may be Len() to add them at the end.
+// Nothing is inserted if the collection fails while it is read.
+func (l *List) InsertRange(index int, collection Enumerable) error {
+	if index < 0 || index > l.Len() {
+		return exception.Newf("Invalid index for InsertRange(%d)", index)
+	}
+
+	items, err := Collect(collection)
+	if err != nil {
+		return err
+	}
+
+	contents := make([]interface{}, 0, l.Len()+items.Len())
+	contents = append(contents, l.contents[:index]...)
+	contents = append(contents, items.contents...)
+	l.contents = append(contents, l.contents[index:]...)
+	return nil
+}
+
+// AddRange adds the elements of the collection to the end of the list.
+// Nothing is added if the collection fails while it is read.
+func (l *List) AddRange(collection Enumerable) error {
+	return l.InsertRange(l.Len(), collection)
+}
+
+func (l *List) Set(index int, item interface{}) error {
+	if index < 0 || index >= l.Len() {
+		return exception.Newf("Invalid index for Set(%d)", index)
+	}
+
+	l.contents[index] = item
+	return nil
+}
+
+// IndexOf returns the index of the first element equal to item, or -1. A nil comparer compares with ==,
+// or with reflect.DeepEqual for values like slices and maps that == cannot compare.
+func (l *List) IndexOf(item interface{}, comparer EqualityComparer) int {
+	for index, value := range l.contents {
+		if areEqual(comparer, value, item) {
+			return index
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last element equal to item, or -1. A nil comparer compares as for IndexOf.
+func (l *List) LastIndexOf(item interface{}, comparer EqualityComparer) int {
+	for index := l.Len() - 1; index >= 0; index-- {
+		if areEqual(comparer, l.contents[index], item) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (l *List) Contains(item interface{}, comparer EqualityComparer) bool {
+	return l.IndexOf(item, comparer) >= 0
+}
+
+// Remove removes the first element equal to item, and returns whether there was one.
+func (l *List) Remove(item interface{}, comparer EqualityComparer) bool {
+	index := l.IndexOf(item, comparer)
+	if index < 0 {
+		return false
+	}
+
+	copy(l.contents[index:], l.contents[index+1:])
+	l.contents[l.Len()-1] = nil
+	l.contents = l.contents[:l.Len()-1]
+	return true
+}
+
+// RemoveAll removes the elements that match the predicate, and returns how many there were.
+func (l *List) RemoveAll(predicate Predicate) int {
+	kept := l.contents[:0]
+	for _, value := range l.contents {
+		if !predicate(value) {
+			kept = append(kept, value)
+		}
+	}
+
+	removed := l.Len() - len(kept)
+	for index := len(kept); index < l.Len(); index++ {
+		l.contents[index] = nil
+	}
+	l.contents = kept
+	return removed
+}
+
+func (l *List) RemoveRange(start, count int) error {
+	if start < 0 || count < 0 || start > l.Len() || count > l.Len()-start {
+		return exception.Newf("Invalid range for RemoveRange(%d, %d)", start, count)
+	}
+
+	kept := append(l.contents[:start], l.contents[start+count:]...)
+	for index := len(kept); index < l.Len(); index++ {
+		l.contents[index] = nil
+	}
+	l.contents = kept
+	return nil
+}
+
+// GetRange returns a new list of count elements starting at start.
+func (l *List) GetRange(start, count int) (*List, error) {
+	if start < 0 || count < 0 || start > l.Len() || count > l.Len()-start {
+		return nil, exception.Newf("Invalid range for GetRange(%d, %d)", start, count)
+	}
+
+	return &List{contents: append([]interface{}{}, l.contents[start:start+count]...)}, nil
+}
+
+// Reverse reverses the order of the elements in place.
+func (l *List) Reverse() {
+	for i, j := 0, l.Len()-1; i < j; i, j = i+1, j-1 {
+		l.Swap(i, j)
+	}
+}
+
+// Sort sorts the list in place with the comparer, or with the comparer for the type of the elements if it
+// is nil; nil elements sort first. The list is left unchanged if the elements cannot be compared.
+func (l *List) Sort(comparer Comparer) error {
+	return l.sort(comparer, sort.Sort)
+}
+
+// SortStable is Sort, except that equal elements keep their order.
+func (l *List) SortStable(comparer Comparer) error {
+	return l.sort(comparer, sort.Stable)
+}
+
+// BinarySearch searches a list sorted with comparer for value, and returns the index of the first element
+// equal to it and true, or the index it would be inserted at and false. A nil comparer is resolved as for Sort.
+func (l *List) BinarySearch(value interface{}, comparer Comparer) (int, bool, error) {
+	comparer, err := l.resolveComparerFor(value, comparer)
+	if err != nil {
+		return 0, false, err
+	}
+
+	index, err := l.search(value, comparer, false)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if index < l.Len() {
+		compareResult, compareErr := compareNilFirst(comparer, l.contents[index], value)
+		if compareErr != nil {
+			return 0, false, compareErr
+		}
+		return index, compareResult == 0, nil
+	}
+	return index, false, nil
+}
+
+// InsertSorted inserts value into a list sorted with comparer, after any elements equal to it, and
+// returns the index it was inserted at. A nil comparer is resolved as for Sort.
+func (l *List) InsertSorted(value interface{}, comparer Comparer) (int, error) {
+	comparer, err := l.resolveComparerFor(value, comparer)
+	if err != nil {
+		return 0, err
+	}
+
+	index, err := l.search(value, comparer, true)
+	if err != nil {
+		return 0, err
+	}
+	return index, l.Insert(index, value)
+}
+
 func (l *List) Clear() {
 	l.contents = []interface{}{}
 }
@@ -78,3 +258,54 @@ func (l *List) GetEnumerator() Enumerator {
 func (l *List) Swap(i, j int) {
 	l.contents[i], l.contents[j] = l.contents[j], l.contents[i]
 }
+
+func (l *List) sort(comparer Comparer, sortFn func(sort.Interface)) error {
+	comparer, err := resolveComparer(comparer, l.contents)
+	if err != nil {
+		return err
+	}
+
+	sorted := &List{contents: append([]interface{}{}, l.contents...)}
+	keys := append([]interface{}{}, l.contents...)
+	sortable := newSortableList(sorted, [][]interface{}{keys}, []Comparer{comparer}, []bool{false})
+	sortFn(sortable)
+	if sortable.err != nil {
+		return sortable.err
+	}
+
+	l.contents = sorted.contents
+	return nil
+}
+
+// search returns the index of the first element that is not less than value, or with upper set, the
+// index of the first element that is greater than it.
+func (l *List) search(value interface{}, comparer Comparer, upper bool) (int, error) {
+	var compareErr error
+	index := sort.Search(l.Len(), func(index int) bool {
+		compareResult, err := compareNilFirst(comparer, l.contents[index], value)
+		if err != nil && compareErr == nil {
+			compareErr = err
+		}
+		if upper {
+			return compareResult > 0
+		}
+		return compareResult >= 0
+	})
+	return index, compareErr
+}
+
+// resolveComparerFor is resolveComparer for value followed by the elements of the list, without copying them.
+func (l *List) resolveComparerFor(value interface{}, comparer Comparer) (Comparer, error) {
+	if comparer == nil && value != nil {
+		return getComparer(reflect.TypeOf(value))
+	}
+	return resolveComparer(comparer, l.contents)
+}
+
+// resolveComparer returns the comparer, or the comparer for the type of the values if it is nil.
+func resolveComparer(comparer Comparer, values []interface{}) (Comparer, error) {
+	if comparer != nil {
+		return comparer, nil
+	}
+	return getComparerForValues(values)
+}
@@ -1,9 +1,11 @@
 package collections
 
 import (
+	"math"
 	"testing"
 
 	"github.com/blendlabs/go-assert"
+	"github.com/blendlabs/go-exception"
 )
 
 func TestListAdd(t *testing.T) {
@@ -36,6 +38,237 @@ func TestListGetEnumerator(t *testing.T) {
 	fromSlice := NewList([]int{1, 2, 3, 4})
 	se := fromSlice.GetEnumerator()
 	a.NotNil(se)
+	a.True(se.MoveNext())
 	value := se.GetCurrent()
 	a.Equal(1, value)
 }
+
+func TestListRemoveAtOutOfRange(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2)
+	a.NotNil(l.RemoveAt(-1))
+	a.NotNil(l.RemoveAt(2))
+	a.Equal(2, l.Len())
+}
+
+func TestListInsert(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 3)
+	a.Nil(l.Insert(1, 2))
+	a.Nil(l.Insert(0, 0))
+	a.Nil(l.Insert(4, 4))
+	a.Equal([]interface{}{0, 1, 2, 3, 4}, l.contents)
+
+	a.NotNil(l.Insert(-1, 0))
+	a.NotNil(l.Insert(6, 0))
+	a.Equal(5, l.Len())
+}
+
+func TestListInsertRange(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 5)
+	a.Nil(l.InsertRange(1, Range(2, 3, 1)))
+	a.Nil(l.AddRange(NewList(6, 7)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5, 6, 7}, l.contents)
+
+	a.Nil(l.AddRange(l))
+	a.Equal(14, l.Len())
+
+	a.NotNil(l.InsertRange(15, NewList(1)))
+	failure := exception.New("read failed")
+	a.Equal(failure, l.AddRange(&failingEnumerable{values: []interface{}{1}, err: failure}))
+	a.Equal(14, l.Len())
+}
+
+func TestListSet(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3)
+	a.Nil(l.Set(1, 20))
+	a.Equal([]interface{}{1, 20, 3}, l.contents)
+	a.NotNil(l.Set(3, 4))
+	a.NotNil(l.Set(-1, 4))
+}
+
+func TestListIndexOf(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 1, 3)
+	a.Equal(0, l.IndexOf(1, nil))
+	a.Equal(2, l.LastIndexOf(1, nil))
+	a.Equal(-1, l.IndexOf(4, nil))
+	a.Equal(-1, l.LastIndexOf(4, nil))
+	a.True(l.Contains(3, nil))
+	a.False(l.Contains(4, nil))
+
+	slices := &List{contents: []interface{}{[]int{1}, []int{2}}}
+	a.Equal(1, slices.IndexOf([]int{2}, DeepEqualityComparer))
+	a.True(slices.Contains([]int{1}, DeepEqualityComparer))
+
+	a.Equal(1, slices.IndexOf([]int{2}, nil))
+	a.Equal(0, slices.LastIndexOf([]int{1}, nil))
+	a.False(slices.Contains([]int{3}, nil))
+	a.True(slices.Remove([]int{1}, nil))
+	a.Equal([]interface{}{[]int{2}}, slices.contents)
+}
+
+func TestListRemove(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 1, 3)
+	a.True(l.Remove(1, nil))
+	a.Equal([]interface{}{2, 1, 3}, l.contents)
+	a.Nil(l.contents[:4][3])
+	a.False(l.Remove(4, nil))
+
+	a.Equal(2, l.RemoveAll(func(value interface{}) bool {
+		return value.(int) < 3
+	}))
+	a.Equal([]interface{}{3}, l.contents)
+	a.Equal(0, l.RemoveAll(func(value interface{}) bool {
+		return false
+	}))
+}
+
+func TestListRanges(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	middle, err := l.GetRange(1, 3)
+	a.Nil(err)
+	a.Equal([]interface{}{2, 3, 4}, middle.contents)
+
+	middle.Add(6)
+	a.Equal(5, l.Len())
+
+	_, err = l.GetRange(3, 3)
+	a.NotNil(err)
+	_, err = l.GetRange(-1, 1)
+	a.NotNil(err)
+
+	a.Nil(l.RemoveRange(1, 2))
+	a.Equal([]interface{}{1, 4, 5}, l.contents)
+	a.Nil(l.contents[:5][3])
+	a.Nil(l.contents[:5][4])
+	a.NotNil(l.RemoveRange(2, 2))
+	a.NotNil(l.RemoveRange(0, -1))
+	a.NotNil(l.RemoveRange(1, math.MaxInt))
+	a.NotNil(l.RemoveRange(4, 0))
+	_, err = l.GetRange(1, math.MaxInt)
+	a.NotNil(err)
+	a.Equal([]interface{}{1, 4, 5}, l.contents)
+
+	l.Reverse()
+	a.Equal([]interface{}{5, 4, 1}, l.contents)
+	empty := NewList()
+	empty.Reverse()
+	a.Equal(0, empty.Len())
+}
+
+func TestListSort(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(3, 1, nil, 2)
+	a.Nil(l.Sort(nil))
+	a.Equal([]interface{}{nil, 1, 2, 3}, l.contents)
+
+	descending := func(this, that interface{}) (int, error) {
+		return numericComparer(that, this)
+	}
+	a.Nil(l.Sort(descending))
+	a.Equal([]interface{}{nil, 3, 2, 1}, l.contents)
+
+	mixed := NewList("b", 1, "a")
+	a.NotNil(mixed.Sort(nil))
+	a.Equal([]interface{}{"b", 1, "a"}, mixed.contents)
+
+	numbers := NewList(2, 2.5, 1.5, 1)
+	a.Nil(numbers.Sort(nil))
+	a.Equal([]interface{}{1, 1.5, 2, 2.5}, numbers.contents)
+}
+
+func TestListSortStable(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(
+		myTestType{Id: 2, Name: "a"},
+		myTestType{Id: 1, Name: "b"},
+		myTestType{Id: 2, Name: "c"},
+		myTestType{Id: 1, Name: "d"},
+	)
+	byId := func(this, that interface{}) (int, error) {
+		return this.(myTestType).Id - that.(myTestType).Id, nil
+	}
+	a.Nil(l.SortStable(byId))
+	a.Equal([]interface{}{"b", "d", "a", "c"}, contentsOf(Map(l, func(value interface{}) interface{} {
+		return value.(myTestType).Name
+	})))
+}
+
+func TestListBinarySearch(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 3, 3, 5)
+	index, found, err := l.BinarySearch(3, nil)
+	a.Nil(err)
+	a.True(found)
+	a.Equal(1, index)
+
+	index, found, err = l.BinarySearch(4, nil)
+	a.Nil(err)
+	a.False(found)
+	a.Equal(3, index)
+
+	index, found, err = l.BinarySearch(6, nil)
+	a.Nil(err)
+	a.False(found)
+	a.Equal(4, index)
+
+	index, found, err = NewList().BinarySearch(1, nil)
+	a.Nil(err)
+	a.False(found)
+	a.Equal(0, index)
+
+	_, _, err = l.BinarySearch("3", nil)
+	a.NotNil(err)
+
+	index, found, err = NewList(nil, 1, 2).BinarySearch(nil, nil)
+	a.Nil(err)
+	a.True(found)
+	a.Equal(0, index)
+
+	index, found, err = NewList(1, 2, 3).BinarySearch(2.5, nil)
+	a.Nil(err)
+	a.False(found)
+	a.Equal(2, index)
+}
+
+func TestListInsertSorted(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList()
+	for _, value := range []int{5, 1, 4, 1, 3} {
+		_, err := l.InsertSorted(value, nil)
+		a.Nil(err)
+	}
+	a.Equal([]interface{}{1, 1, 3, 4, 5}, l.contents)
+
+	index, err := l.InsertSorted(3, nil)
+	a.Nil(err)
+	a.Equal(3, index)
+
+	strings := NewList("b", "d")
+	index, err = strings.InsertSorted("c", nil)
+	a.Nil(err)
+	a.Equal(1, index)
+	a.Equal([]interface{}{"b", "c", "d"}, strings.contents)
+
+	withNil := NewList(nil, "b")
+	index, err = withNil.InsertSorted("a", nil)
+	a.Nil(err)
+	a.Equal(1, index)
+	a.Equal([]interface{}{nil, "a", "b"}, withNil.contents)
+}
@@ -0,0 +1,123 @@
+package collections
+
+import "github.com/blendlabs/go-exception"
+
+// FromMap returns an Enumerable over the entries of a go map as KeyValuePairs, in go's unspecified map order.
+// It returns nil if contents is not a map.
+func FromMap(contents interface{}) Enumerable {
+	if !isMap(contents) {
+		return nil
+	}
+	return &mapEnumerable{contents: contents}
+}
+
+// FromSortedMap is FromMap with the entries ordered by key, as with NewSortedMapEnumerator.
+func FromSortedMap(contents interface{}, comparer Comparer) Enumerable {
+	if !isMap(contents) {
+		return nil
+	}
+	return &mapEnumerable{contents: contents, sorted: true, comparer: comparer}
+}
+
+// Keys returns a lazy enumerable of the keys of a collection of KeyValuePairs. It stops at the first element
+// that is not a KeyValuePair, and its enumerator's Err method returns the error.
+func Keys(collection Enumerable) Enumerable {
+	return &entryPartEnumerable{source: collection}
+}
+
+// Values returns a lazy enumerable of the values of a collection of KeyValuePairs. It stops at the first element
+// that is not a KeyValuePair, and its enumerator's Err method returns the error.
+func Values(collection Enumerable) Enumerable {
+	return &entryPartEnumerable{source: collection, values: true}
+}
+
+// keyValuePairOf returns value as a KeyValuePair, or an error if it is not one.
+func keyValuePairOf(value interface{}) (KeyValuePair, error) {
+	pair, isPair := value.(KeyValuePair)
+	if !isPair {
+		return KeyValuePair{}, exception.Newf("%v is not a KeyValuePair", value)
+	}
+	return pair, nil
+}
+
+// --------------------------------------------------------------------------------
+// mapEnumerable
+// --------------------------------------------------------------------------------
+
+type mapEnumerable struct {
+	contents interface{}
+	sorted   bool
+	comparer Comparer
+}
+
+func (me *mapEnumerable) GetEnumerator() Enumerator {
+	if me.sorted {
+		return NewSortedMapEnumerator(me.contents, me.comparer)
+	}
+	return NewMapEnumerator(me.contents)
+}
+
+// --------------------------------------------------------------------------------
+// entryPartEnumerable
+// --------------------------------------------------------------------------------
+
+// entryPartEnumerable yields the keys, or the values, of a collection of KeyValuePairs.
+type entryPartEnumerable struct {
+	source Enumerable
+	values bool
+}
+
+func (ee *entryPartEnumerable) GetEnumerator() Enumerator {
+	return &entryPartEnumerator{source: ee.source.GetEnumerator(), values: ee.values}
+}
+
+type entryPartEnumerator struct {
+	source  Enumerator
+	values  bool
+	valid   bool
+	current interface{}
+	err     error
+}
+
+func (ee *entryPartEnumerator) MoveNext() bool {
+	ee.valid, ee.current = false, nil
+	if ee.err != nil || !ee.source.MoveNext() {
+		return false
+	}
+
+	pair, err := keyValuePairOf(ee.source.GetCurrent())
+	if err != nil {
+		ee.err = err
+		return false
+	}
+	if ee.values {
+		ee.current = pair.Value
+	} else {
+		ee.current = pair.Key
+	}
+	ee.valid = true
+	return true
+}
+
+func (ee *entryPartEnumerator) GetCurrent() interface{} {
+	if !ee.valid {
+		return nil
+	}
+	return ee.current
+}
+
+func (ee *entryPartEnumerator) Err() error {
+	if ee.err != nil {
+		return ee.err
+	}
+	return enumeratorErr(ee.source)
+}
+
+func (ee *entryPartEnumerator) Close() error {
+	return closeEnumerator(ee.source)
+}
+
+func (ee *entryPartEnumerator) Reset() {
+	ee.valid, ee.current, ee.err = false, nil, nil
+	ee.source.Reset()
+}
@@ -0,0 +1,139 @@
+package collections
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestFromMap(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+	a.Equal(3, must[int](a)(Count(FromMap(myMap), nil)))
+	a.Nil(FromMap([]int{1, 2}))
+	a.Nil(FromSortedMap(nil, nil))
+
+	var copied map[string]int
+	a.Nil(ToMap(FromMap(myMap), &copied, nil, nil, DuplicateKeyError))
+	a.Equal(myMap, copied)
+
+	dictionary, err := ToDictionary(FromMap(myMap), nil, nil, DuplicateKeyError)
+	a.Nil(err)
+	a.Equal(map[interface{}]interface{}{"foo": 1, "bar": 2, "baz": 3}, dictionary)
+}
+
+func TestFromSortedMap(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+	a.Equal([]interface{}{"bar", "baz", "foo"}, contentsOf(Keys(FromSortedMap(myMap, nil))))
+	a.Equal([]interface{}{2, 3, 1}, contentsOf(Values(FromSortedMap(myMap, nil))))
+
+	descending := func(this, that interface{}) (int, error) {
+		return stringComparer(that, this)
+	}
+	a.Equal([]interface{}{"foo", "baz", "bar"}, contentsOf(Keys(FromSortedMap(myMap, descending))))
+
+	var doubled map[string]int
+	a.Nil(ToMap(FromSortedMap(myMap, nil), &doubled, nil, func(value interface{}) interface{} {
+		return value.(KeyValuePair).Value.(int) * 2
+	}, DuplicateKeyError))
+	a.Equal(map[string]int{"foo": 2, "bar": 4, "baz": 6}, doubled)
+}
+
+func TestSortedMapEnumeratorReset(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[int]string{3: "c", 1: "a"}
+	e := NewSortedMapEnumerator(myMap, nil)
+	a.True(e.MoveNext())
+	a.Equal(KeyValuePair{Key: 1, Value: "a"}, e.GetCurrent())
+
+	myMap[2] = "b"
+	e.Reset()
+	var keys []interface{}
+	for e.MoveNext() {
+		key, _ := e.GetCurrentWithKey()
+		keys = append(keys, key)
+	}
+	a.Equal([]interface{}{1, 2, 3}, keys)
+
+	delete(myMap, 1)
+	myMap[0] = "z"
+	e.Reset()
+	keys = nil
+	for e.MoveNext() {
+		key, _ := e.GetCurrentWithKey()
+		keys = append(keys, key)
+	}
+	a.Equal([]interface{}{0, 2, 3}, keys)
+}
+
+func TestSortedMapEnumeratorUncomparableKeys(t *testing.T) {
+	a := assert.New(t)
+
+	e := NewSortedMapEnumerator(map[interface{}]int{myTestType{Id: 1}: 1, myTestType{Id: 2}: 2}, nil)
+	a.False(e.MoveNext())
+	a.NotNil(e.Err())
+
+	_, err := Collect(Keys(FromSortedMap(map[interface{}]int{myTestType{Id: 1}: 1, myTestType{Id: 2}: 2}, nil)))
+	a.NotNil(err)
+}
+
+func TestEntriesThatAreNotKeyValuePairs(t *testing.T) {
+	a := assert.New(t)
+
+	notPairs := NewList(KeyValuePair{Key: "foo", Value: 1}, "bar")
+
+	keys, err := Collect(Keys(notPairs))
+	a.NotNil(err)
+	a.Equal([]interface{}{"foo"}, keys.contents)
+	_, err = Collect(Values(notPairs))
+	a.NotNil(err)
+
+	var byKey map[string]int
+	a.NotNil(ToMap(notPairs, &byKey, nil, nil, DuplicateKeyError))
+	a.Nil(byKey)
+	_, err = ToDictionary(notPairs, nil, nil, DuplicateKeyError)
+	a.NotNil(err)
+}
+
+func TestFromMapUnusualKeys(t *testing.T) {
+	a := assert.New(t)
+
+	withNil := map[interface{}]int{nil: 1, "foo": 2}
+	var copied map[interface{}]int
+	a.Nil(ToMap(FromMap(withNil), &copied, nil, nil, DuplicateKeyError))
+	a.Equal(withNil, copied)
+	a.Equal([]interface{}{KeyValuePair{Key: nil, Value: 1}, KeyValuePair{Key: "foo", Value: 2}}, contentsOf(FromSortedMap(withNil, nil)))
+
+	nan := math.NaN()
+	withNaN := map[float64]string{nan: "a", nan: "b", 1: "c"}
+	values, err := Collect(SortBy(Values(FromMap(withNaN)), DefaultKeySelector))
+	a.Nil(err)
+	a.Equal([]interface{}{"a", "b", "c"}, values.contents)
+
+	e := NewMapEnumerator(withNaN)
+	e.Reset()
+	a.Equal(3, must[int](a)(Count(FromMap(withNaN), nil)))
+	a.True(e.MoveNext())
+}
+
+func TestMapEnumeratorChangedMap(t *testing.T) {
+	a := assert.New(t)
+
+	myMap := map[string]int{"foo": 1, "bar": 2}
+	e := NewSortedMapEnumerator(myMap, nil)
+	delete(myMap, "foo")
+	a.True(e.MoveNext())
+	a.True(e.MoveNext())
+	a.Equal(KeyValuePair{Key: "foo", Value: 1}, e.GetCurrent())
+
+	myMap["bar"] = 3
+	e.Reset()
+	a.True(e.MoveNext())
+	a.Equal(KeyValuePair{Key: "bar", Value: 3}, e.GetCurrent())
+	a.False(e.MoveNext())
+}
@@ -0,0 +1,250 @@
+package collections
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ParallelQuery applies Map and Filter steps to the elements of a collection across a bounded pool of
+// goroutines. Steps are recorded as they are chained and run when a terminal method (ForEach, ToList,
+// Count, Sum or Aggregate) is called. The source is enumerated from a single goroutine.
+type ParallelQuery struct {
+	source  Enumerable
+	workers int
+	ordered bool
+	steps   []parallelStep
+}
+
+// AsParallel starts a parallel query over the collection with the given number of worker goroutines;
+// fewer than one is treated as one.
+func AsParallel(collection Enumerable, workers int) *ParallelQuery {
+	if workers < 1 {
+		workers = 1
+	}
+	return &ParallelQuery{source: collection, workers: workers}
+}
+
+// AsOrdered returns a query whose results are in source order rather than the order they complete in.
+func (pq *ParallelQuery) AsOrdered() *ParallelQuery {
+	ordered := pq.clone()
+	ordered.ordered = true
+	return ordered
+}
+
+// Map adds a projection step.
+func (pq *ParallelQuery) Map(mapFn MapAction) *ParallelQuery {
+	return pq.with(parallelStep{mapFn: mapFn})
+}
+
+// Filter adds a step that drops the elements that do not match the predicate.
+func (pq *ParallelQuery) Filter(predicate Predicate) *ParallelQuery {
+	return pq.with(parallelStep{predicate: predicate})
+}
+
+// ForEach runs the query and calls action on each result from the worker goroutines, so action must be
+// safe to call concurrently and is not called in order even for an ordered query.
+func (pq *ParallelQuery) ForEach(action func(value interface{})) error {
+	_, err := pq.execute(action)
+	return err
+}
+
+// ToList runs the query and returns the results.
+func (pq *ParallelQuery) ToList() (*List, error) {
+	results, err := pq.execute(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &List{contents: results}, nil
+}
+
+// Count runs the query and returns the number of results.
+func (pq *ParallelQuery) Count() (int, error) {
+	results, err := pq.execute(nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(results), nil
+}
+
+// Sum runs the query and adds up the results, which may be any mix of numeric types.
+func (pq *ParallelQuery) Sum() (float64, error) {
+	results, err := pq.ToList()
+	if err != nil {
+		return 0, err
+	}
+	return Sum(results)
+}
+
+// Aggregate runs the query and folds the results, in the order ToList would return them, starting from seed.
+func (pq *ParallelQuery) Aggregate(seed interface{}, fn AggregateAction) (interface{}, error) {
+	results, err := pq.ToList()
+	if err != nil {
+		return nil, err
+	}
+	return Aggregate(results, seed, fn)
+}
+
+// --------------------------------------------------------------------------------
+// errors
+// --------------------------------------------------------------------------------
+
+// ElementError is a panic recovered while running the steps of a parallel query on one element, or while reading
+// it from the source (for example in a lazy Map upstream of AsParallel), in which case Value is nil.
+type ElementError struct {
+	Index     int
+	Value     interface{}
+	Recovered interface{}
+}
+
+func (ee *ElementError) Error() string {
+	return fmt.Sprintf("element %d (%v) panicked: %v", ee.Index, ee.Value, ee.Recovered)
+}
+
+// ParallelError holds every ElementError from a parallel query, ordered by element index.
+type ParallelError []*ElementError
+
+func (pe ParallelError) Error() string {
+	if len(pe) == 1 {
+		return pe[0].Error()
+	}
+	return fmt.Sprintf("%d elements panicked; the first: %v", len(pe), pe[0])
+}
+
+// --------------------------------------------------------------------------------
+// execution
+// --------------------------------------------------------------------------------
+
+// parallelStep is either a projection or a filter.
+type parallelStep struct {
+	mapFn     MapAction
+	predicate Predicate
+}
+
+type parallelResult struct {
+	index int
+	value interface{}
+	keep  bool
+	err   *ElementError
+}
+
+func (pq *ParallelQuery) clone() *ParallelQuery {
+	steps := make([]parallelStep, len(pq.steps), len(pq.steps)+1)
+	copy(steps, pq.steps)
+	return &ParallelQuery{
+		source:  pq.source,
+		workers: pq.workers,
+		ordered: pq.ordered,
+		steps:   steps,
+	}
+}
+
+func (pq *ParallelQuery) with(step parallelStep) *ParallelQuery {
+	query := pq.clone()
+	query.steps = append(query.steps, step)
+	return query
+}
+
+// execute runs the query and returns the results, or passes each one to action instead of keeping it if
+// action is not nil.
+func (pq *ParallelQuery) execute(action func(value interface{})) ([]interface{}, error) {
+	jobs := make(chan parallelResult)
+	var sourceErr error
+	var sourceFailure *ElementError
+	go func() {
+		defer close(jobs)
+		index := 0
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				sourceFailure = &ElementError{Index: index, Recovered: recovered}
+			}
+		}()
+
+		e := pq.source.GetEnumerator()
+		defer closeEnumerator(e)
+		for ; e.MoveNext(); index++ {
+			jobs <- parallelResult{index: index, value: e.GetCurrent()}
+		}
+		sourceErr = enumeratorErr(e)
+	}()
+
+	results := make(chan parallelResult)
+	wg := sync.WaitGroup{}
+	for worker := 0; worker < pq.workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- pq.process(job, action)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var kept []parallelResult
+	var failures ParallelError
+	for result := range results {
+		if result.err != nil {
+			failures = append(failures, result.err)
+		} else if result.keep {
+			kept = append(kept, result)
+		}
+	}
+	if sourceFailure != nil {
+		failures = append(failures, sourceFailure)
+	}
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool {
+			return failures[i].Index < failures[j].Index
+		})
+		return nil, failures
+	}
+	if sourceErr != nil {
+		return nil, sourceErr
+	}
+
+	if pq.ordered {
+		sort.Slice(kept, func(i, j int) bool {
+			return kept[i].index < kept[j].index
+		})
+	}
+	values := make([]interface{}, len(kept))
+	for index, result := range kept {
+		values[index] = result.value
+	}
+	return values, nil
+}
+
+// process runs the steps, and then the action, on one element, recovering any panic as an ElementError.
+// A result passed to the action is not kept.
+func (pq *ParallelQuery) process(job parallelResult, action func(value interface{})) (result parallelResult) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = parallelResult{
+				index: job.index,
+				err:   &ElementError{Index: job.index, Value: job.value, Recovered: recovered},
+			}
+		}
+	}()
+
+	value := job.value
+	for _, step := range pq.steps {
+		if step.predicate != nil {
+			if !step.predicate(value) {
+				return parallelResult{index: job.index}
+			}
+		} else if step.mapFn != nil {
+			value = step.mapFn(value)
+		}
+	}
+
+	if action != nil {
+		action(value)
+		return parallelResult{index: job.index}
+	}
+	return parallelResult{index: job.index, value: value, keep: true}
+}
@@ -0,0 +1,138 @@
+package collections
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+// slowDouble takes longer for smaller values, so unordered results tend to complete in reverse.
+func slowDouble(value interface{}) interface{} {
+	time.Sleep(time.Duration(10-value.(int)) * time.Millisecond)
+	return value.(int) * 2
+}
+
+func TestParallelOrdered(t *testing.T) {
+	a := assert.New(t)
+
+	results, err := AsParallel(NewList(1, 2, 3, 4, 5, 6, 7, 8), 4).
+		AsOrdered().
+		Map(slowDouble).
+		Filter(func(value interface{}) bool {
+			return value.(int)%4 == 0
+		}).
+		ToList()
+	a.Nil(err)
+	a.Equal([]interface{}{4, 8, 12, 16}, results.contents)
+}
+
+func TestParallelUnordered(t *testing.T) {
+	a := assert.New(t)
+
+	results, err := AsParallel(NewList(1, 2, 3, 4, 5, 6, 7, 8), 8).Map(slowDouble).ToList()
+	a.Nil(err)
+	a.Equal(8, results.Len())
+
+	sorted := ToList(SortBy(results, DefaultKeySelector)).(*List)
+	a.Equal([]interface{}{2, 4, 6, 8, 10, 12, 14, 16}, sorted.contents)
+}
+
+func TestParallelBoundedWorkers(t *testing.T) {
+	a := assert.New(t)
+
+	var running, maxRunning int32
+	err := AsParallel(NewList(1, 2, 3, 4, 5, 6, 7, 8, 9), 3).ForEach(func(value interface{}) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	})
+	a.Nil(err)
+	a.True(maxRunning <= 3)
+	a.True(maxRunning > 1)
+}
+
+func TestParallelForEach(t *testing.T) {
+	a := assert.New(t)
+
+	var lock sync.Mutex
+	seen := map[interface{}]bool{}
+	err := AsParallel(NewList(1, 2, 3), 2).Map(slowDouble).ForEach(func(value interface{}) {
+		lock.Lock()
+		defer lock.Unlock()
+		seen[value] = true
+	})
+	a.Nil(err)
+	a.Equal(map[interface{}]bool{2: true, 4: true, 6: true}, seen)
+
+	kept, err := AsParallel(NewList(1, 2, 3), 2).execute(func(value interface{}) {})
+	a.Nil(err)
+	a.Empty(kept)
+}
+
+func TestParallelPanics(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := AsParallel(NewList(1, 2, 3, 4, 5), 2).Map(func(value interface{}) interface{} {
+		if value.(int)%2 == 0 {
+			panic("even")
+		}
+		return value
+	}).ToList()
+	a.NotNil(err)
+
+	parallelErr := err.(ParallelError)
+	a.Len(parallelErr, 2)
+	a.Equal(1, parallelErr[0].Index)
+	a.Equal(2, parallelErr[0].Value)
+	a.Equal("even", parallelErr[0].Recovered)
+	a.Equal(3, parallelErr[1].Index)
+}
+
+func TestParallelSourcePanics(t *testing.T) {
+	a := assert.New(t)
+
+	source := Map(NewList(1, 2, 3), func(value interface{}) interface{} {
+		if value.(int) == 3 {
+			panic("upstream")
+		}
+		return value
+	})
+	_, err := AsParallel(source, 2).ToList()
+	a.NotNil(err)
+
+	parallelErr := err.(ParallelError)
+	a.Len(parallelErr, 1)
+	a.Equal(2, parallelErr[0].Index)
+	a.Nil(parallelErr[0].Value)
+	a.Equal("upstream", parallelErr[0].Recovered)
+}
+
+func TestParallelAggregations(t *testing.T) {
+	a := assert.New(t)
+
+	query := AsParallel(NewList(1, 2, 3, 4), 4).AsOrdered().Map(slowDouble)
+
+	count, err := query.Count()
+	a.Nil(err)
+	a.Equal(4, count)
+
+	sum, err := query.Sum()
+	a.Nil(err)
+	a.Equal(20.0, sum)
+
+	joined, err := query.Aggregate("", func(accumulator, value interface{}) interface{} {
+		return accumulator.(string) + strconv.Itoa(value.(int))
+	})
+	a.Nil(err)
+	a.Equal("2468", joined)
+}
@@ -0,0 +1,310 @@
+package collections
+
+// Take returns a lazy enumerable of the first count elements of the collection.
+// It never advances the source past the count-th element, so it is safe to use on infinite sources.
+func Take(collection Enumerable, count int) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &takeEnumerator{source: source, count: count}
+	}, source: collection}
+}
+
+// Skip returns a lazy enumerable of the elements of the collection after the first count.
+func Skip(collection Enumerable, count int) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &skipEnumerator{source: source, count: count}
+	}, source: collection}
+}
+
+// TakeWhile returns a lazy enumerable of the elements of the collection up to the first one
+// that does not match the predicate.
+func TakeWhile(collection Enumerable, predicate Predicate) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &takeWhileEnumerator{source: source, predicate: predicate}
+	}, source: collection}
+}
+
+// SkipWhile returns a lazy enumerable of the elements of the collection from the first one
+// that does not match the predicate.
+func SkipWhile(collection Enumerable, predicate Predicate) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &skipWhileEnumerator{source: source, predicate: predicate}
+	}, source: collection}
+}
+
+// TakeLast returns a lazy enumerable of the last count elements of the collection.
+// Only count elements are buffered, but the whole collection is read on the first MoveNext.
+func TakeLast(collection Enumerable, count int) Enumerable {
+	return &deferredEnumerable{build: func() Enumerator {
+		buffer := newRingBuffer(count)
+		e := collection.GetEnumerator()
+		defer closeEnumerator(e)
+		for e.MoveNext() {
+			if count > 0 {
+				buffer.Push(e.GetCurrent())
+			}
+		}
+		if err := enumeratorErr(e); err != nil {
+			return &errorEnumerator{err: err}
+		}
+		return NewSliceEnumerator(buffer.Values())
+	}}
+}
+
+// SkipLast returns a lazy enumerable of the elements of the collection except the last count.
+// It buffers count elements ahead of the one it yields.
+func SkipLast(collection Enumerable, count int) Enumerable {
+	return &partitionEnumerable{build: func(source Enumerator) Enumerator {
+		return &skipLastEnumerator{source: source, buffer: newRingBuffer(count)}
+	}, source: collection}
+}
+
+// --------------------------------------------------------------------------------
+// partitionEnumerable
+// --------------------------------------------------------------------------------
+
+type partitionEnumerable struct {
+	source Enumerable
+	build  func(source Enumerator) Enumerator
+}
+
+func (pe *partitionEnumerable) GetEnumerator() Enumerator {
+	return pe.build(pe.source.GetEnumerator())
+}
+
+type takeEnumerator struct {
+	source Enumerator
+	count  int
+	taken  int
+	valid  bool
+}
+
+func (te *takeEnumerator) MoveNext() bool {
+	te.valid = te.taken < te.count && te.source.MoveNext()
+	if te.valid {
+		te.taken = te.taken + 1
+	} else {
+		te.taken = te.count
+	}
+	return te.valid
+}
+
+func (te *takeEnumerator) GetCurrent() interface{} {
+	if !te.valid {
+		return nil
+	}
+	return te.source.GetCurrent()
+}
+
+func (te *takeEnumerator) Err() error {
+	return enumeratorErr(te.source)
+}
+
+func (te *takeEnumerator) Close() error {
+	return closeEnumerator(te.source)
+}
+
+func (te *takeEnumerator) Reset() {
+	te.taken, te.valid = 0, false
+	te.source.Reset()
+}
+
+type skipEnumerator struct {
+	source  Enumerator
+	count   int
+	skipped bool
+}
+
+func (se *skipEnumerator) MoveNext() bool {
+	if !se.skipped {
+		se.skipped = true
+		for index := 0; index < se.count; index++ {
+			if !se.source.MoveNext() {
+				return false
+			}
+		}
+	}
+	return se.source.MoveNext()
+}
+
+func (se *skipEnumerator) GetCurrent() interface{} {
+	return se.source.GetCurrent()
+}
+
+func (se *skipEnumerator) Err() error {
+	return enumeratorErr(se.source)
+}
+
+func (se *skipEnumerator) Close() error {
+	return closeEnumerator(se.source)
+}
+
+func (se *skipEnumerator) Reset() {
+	se.skipped = false
+	se.source.Reset()
+}
+
+type takeWhileEnumerator struct {
+	source    Enumerator
+	predicate Predicate
+	done      bool
+}
+
+func (te *takeWhileEnumerator) MoveNext() bool {
+	if te.done {
+		return false
+	}
+	if !te.source.MoveNext() || !te.predicate(te.source.GetCurrent()) {
+		te.done = true
+		return false
+	}
+	return true
+}
+
+func (te *takeWhileEnumerator) GetCurrent() interface{} {
+	if te.done {
+		return nil
+	}
+	return te.source.GetCurrent()
+}
+
+func (te *takeWhileEnumerator) Err() error {
+	return enumeratorErr(te.source)
+}
+
+func (te *takeWhileEnumerator) Close() error {
+	return closeEnumerator(te.source)
+}
+
+func (te *takeWhileEnumerator) Reset() {
+	te.done = false
+	te.source.Reset()
+}
+
+type skipWhileEnumerator struct {
+	source    Enumerator
+	predicate Predicate
+	skipped   bool
+}
+
+func (se *skipWhileEnumerator) MoveNext() bool {
+	if se.skipped {
+		return se.source.MoveNext()
+	}
+
+	se.skipped = true
+	for se.source.MoveNext() {
+		if !se.predicate(se.source.GetCurrent()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (se *skipWhileEnumerator) GetCurrent() interface{} {
+	return se.source.GetCurrent()
+}
+
+func (se *skipWhileEnumerator) Err() error {
+	return enumeratorErr(se.source)
+}
+
+func (se *skipWhileEnumerator) Close() error {
+	return closeEnumerator(se.source)
+}
+
+func (se *skipWhileEnumerator) Reset() {
+	se.skipped = false
+	se.source.Reset()
+}
+
+type skipLastEnumerator struct {
+	source  Enumerator
+	buffer  *ringBuffer
+	valid   bool
+	current interface{}
+}
+
+func (se *skipLastEnumerator) MoveNext() bool {
+	for se.source.MoveNext() {
+		value := se.source.GetCurrent()
+		if se.buffer.Capacity() == 0 {
+			se.current, se.valid = value, true
+			return true
+		}
+		if evicted, isFull := se.buffer.Push(value); isFull {
+			se.current, se.valid = evicted, true
+			return true
+		}
+	}
+	se.current, se.valid = nil, false
+	return false
+}
+
+func (se *skipLastEnumerator) GetCurrent() interface{} {
+	if !se.valid {
+		return nil
+	}
+	return se.current
+}
+
+func (se *skipLastEnumerator) Err() error {
+	return enumeratorErr(se.source)
+}
+
+func (se *skipLastEnumerator) Close() error {
+	return closeEnumerator(se.source)
+}
+
+func (se *skipLastEnumerator) Reset() {
+	se.buffer = newRingBuffer(se.buffer.Capacity())
+	se.current, se.valid = nil, false
+	se.source.Reset()
+}
+
+// --------------------------------------------------------------------------------
+// ringBuffer
+// --------------------------------------------------------------------------------
+
+// ringBuffer holds the most recent values pushed to it, up to its capacity. It grows as values are pushed,
+// so a huge capacity costs nothing until it is filled.
+type ringBuffer struct {
+	values   []interface{}
+	capacity int
+	head     int
+}
+
+func newRingBuffer(capacity int) *ringBuffer {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &ringBuffer{capacity: capacity}
+}
+
+func (rb *ringBuffer) Capacity() int {
+	return rb.capacity
+}
+
+// Push adds a value, evicting and returning the oldest value if the buffer was already full.
+func (rb *ringBuffer) Push(value interface{}) (interface{}, bool) {
+	if len(rb.values) < rb.capacity {
+		rb.values = append(rb.values, value)
+		return nil, false
+	}
+	if rb.capacity == 0 {
+		return value, true
+	}
+
+	evicted := rb.values[rb.head]
+	rb.values[rb.head] = value
+	rb.head = (rb.head + 1) % len(rb.values)
+	return evicted, true
+}
+
+// Values returns the buffered values, oldest first.
+func (rb *ringBuffer) Values() []interface{} {
+	values := make([]interface{}, len(rb.values))
+	for index := range values {
+		values[index] = rb.values[(rb.head+index)%len(rb.values)]
+	}
+	return values
+}
@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func contentsOf(collection Enumerable) []interface{} {
+	return ToList(collection).(*List).contents
+}
+
+func lessThan(limit int) Predicate {
+	return func(value interface{}) bool {
+		return value.(int) < limit
+	}
+}
+
+func TestTake(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{1, 2}, contentsOf(Take(l, 2)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(Take(l, 10)))
+	a.Empty(contentsOf(Take(l, 0)))
+
+	source := &countingEnumerable{}
+	a.Equal([]interface{}{0, 1, 2}, contentsOf(Take(source, 3)))
+	a.Equal(3, source.pulled)
+}
+
+func TestSkip(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{4, 5}, contentsOf(Skip(l, 3)))
+	a.Empty(contentsOf(Skip(l, 10)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(Skip(l, 0)))
+
+	a.Equal([]interface{}{20, 21}, contentsOf(Take(Skip(&countingEnumerable{}, 20), 2)))
+}
+
+func TestTakeWhile(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{1, 2}, contentsOf(TakeWhile(NewList(1, 2, 3, 1), lessThan(3))))
+	a.Equal([]interface{}{0, 1, 2, 3}, contentsOf(TakeWhile(&countingEnumerable{}, lessThan(4))))
+	a.Empty(contentsOf(TakeWhile(NewList(5, 1), lessThan(3))))
+}
+
+func TestSkipWhile(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]interface{}{3, 1}, contentsOf(SkipWhile(NewList(1, 2, 3, 1), lessThan(3))))
+	a.Empty(contentsOf(SkipWhile(NewList(1, 2), lessThan(3))))
+}
+
+func TestTakeLast(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{4, 5}, contentsOf(TakeLast(l, 2)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(TakeLast(l, 10)))
+	a.Empty(contentsOf(TakeLast(l, 0)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(TakeLast(l, math.MaxInt)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(TakeLast(l, 1<<34)))
+}
+
+func TestSkipLast(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4, 5)
+	a.Equal([]interface{}{1, 2, 3}, contentsOf(SkipLast(l, 2)))
+	a.Empty(contentsOf(SkipLast(l, 10)))
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, contentsOf(SkipLast(l, 0)))
+	a.Empty(contentsOf(SkipLast(l, math.MaxInt)))
+
+	a.Equal([]interface{}{0, 1, 2}, contentsOf(Take(SkipLast(&countingEnumerable{}, 3), 3)))
+}
+
+func TestPaging(t *testing.T) {
+	a := assert.New(t)
+
+	page := func(index, size int) []interface{} {
+		return contentsOf(Take(Skip(&countingEnumerable{}, index*size), size))
+	}
+	a.Equal([]interface{}{0, 1, 2}, page(0, 3))
+	a.Equal([]interface{}{9, 10, 11}, page(3, 3))
+}
@@ -0,0 +1,92 @@
+package collections
+
+import "reflect"
+
+// Any returns true if any element matches the predicate; a nil predicate matches every element.
+// It stops at the first match.
+func Any(collection Enumerable, predicate Predicate) (bool, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		if predicate == nil || predicate(e.GetCurrent()) {
+			return true, nil
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// AllMatch returns true if every element matches the predicate, which is true for an empty collection;
+// a nil predicate matches every element. It stops at the first element that does not match.
+func AllMatch(collection Enumerable, predicate Predicate) (bool, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		if predicate != nil && !predicate(e.GetCurrent()) {
+			return false, nil
+		}
+	}
+	if err := enumeratorErr(e); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Contains returns true if any element equals value. A nil comparer compares with ==, or with
+// reflect.DeepEqual for values like slices and maps that == cannot compare.
+func Contains(collection Enumerable, value interface{}, comparer EqualityComparer) (bool, error) {
+	return Any(collection, func(current interface{}) bool {
+		return areEqual(comparer, current, value)
+	})
+}
+
+// SequenceEqual returns true if both collections have the same number of elements and the elements
+// at each position are equal. A nil comparer compares as for Contains.
+func SequenceEqual(first, second Enumerable, comparer EqualityComparer) (bool, error) {
+	firstEnumerator := first.GetEnumerator()
+	defer closeEnumerator(firstEnumerator)
+	secondEnumerator := second.GetEnumerator()
+	defer closeEnumerator(secondEnumerator)
+	for {
+		hasFirst := firstEnumerator.MoveNext()
+		if !hasFirst {
+			if err := enumeratorErr(firstEnumerator); err != nil {
+				return false, err
+			}
+		}
+		hasSecond := secondEnumerator.MoveNext()
+		if !hasSecond {
+			if err := enumeratorErr(secondEnumerator); err != nil {
+				return false, err
+			}
+		}
+
+		if hasFirst != hasSecond {
+			return false, nil
+		}
+		if !hasFirst {
+			return true, nil
+		}
+		if !areEqual(comparer, firstEnumerator.GetCurrent(), secondEnumerator.GetCurrent()) {
+			return false, nil
+		}
+	}
+}
+
+// areEqual compares with comparer, or if it is nil with ==, falling back to reflect.DeepEqual for values
+// that == would panic on, like slices and maps.
+func areEqual(comparer EqualityComparer, this, that interface{}) bool {
+	if comparer != nil {
+		return comparer.Equals(this, that)
+	}
+	if isComparable(this) && isComparable(that) {
+		return this == that
+	}
+	return reflect.DeepEqual(this, that)
+}
+
+func isComparable(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).Comparable()
+}
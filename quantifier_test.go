@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestAny(t *testing.T) {
+	a := assert.New(t)
+	ok := must[bool](a)
+
+	a.True(ok(Any(NewList(1, 2, 3), nil)))
+	a.False(ok(Any(NewList(), nil)))
+	a.True(ok(Any(NewList(1, 2, 3), lessThan(2))))
+	a.False(ok(Any(NewList(1, 2, 3), lessThan(1))))
+
+	source := &countingEnumerable{}
+	a.True(ok(Any(source, func(value interface{}) bool {
+		return value.(int) == 4
+	})))
+	a.Equal(5, source.pulled)
+}
+
+func TestAllMatch(t *testing.T) {
+	a := assert.New(t)
+	ok := must[bool](a)
+
+	a.True(ok(AllMatch(NewList(1, 2, 3), lessThan(4))))
+	a.False(ok(AllMatch(NewList(1, 2, 3), lessThan(3))))
+	a.True(ok(AllMatch(NewList(), lessThan(0))))
+	a.True(ok(AllMatch(NewList(1, 2, 3), nil)))
+
+	source := &countingEnumerable{}
+	a.False(ok(AllMatch(source, lessThan(3))))
+	a.Equal(4, source.pulled)
+}
+
+func TestContains(t *testing.T) {
+	a := assert.New(t)
+	ok := must[bool](a)
+
+	a.True(ok(Contains(NewList(1, 2, 3), 2, nil)))
+	a.False(ok(Contains(NewList(1, 2, 3), 4, nil)))
+	a.False(ok(Contains(NewList(1, 2, 3), int64(2), nil)))
+	a.True(ok(Contains(&List{contents: []interface{}{[]int{1}, []int{2}}}, []int{2}, DeepEqualityComparer)))
+
+	a.True(ok(Contains(&List{contents: []interface{}{[]int{1}, []int{2}}}, []int{2}, nil)))
+	a.False(ok(Contains(&List{contents: []interface{}{map[string]int{"a": 1}}}, map[string]int{"a": 2}, nil)))
+	a.True(ok(Contains(&List{contents: []interface{}{[1]interface{}{[]int{1}}}}, [1]interface{}{[]int{1}}, nil)))
+}
+
+func TestSequenceEqual(t *testing.T) {
+	a := assert.New(t)
+	ok := must[bool](a)
+
+	a.True(ok(SequenceEqual(NewList(1, 2, 3), Map(NewList(0, 1, 2), func(value interface{}) interface{} {
+		return value.(int) + 1
+	}), nil)))
+	a.True(ok(SequenceEqual(NewList(), NewList(), nil)))
+	a.False(ok(SequenceEqual(NewList(1, 2), NewList(1, 2, 3), nil)))
+	a.False(ok(SequenceEqual(NewList(1, 2, 3), NewList(1, 2), nil)))
+	a.False(ok(SequenceEqual(NewList(1, 2, 3), NewList(1, 3, 2), nil)))
+	a.False(ok(SequenceEqual(NewList(1, 2), &countingEnumerable{}, nil)))
+	a.True(ok(SequenceEqual(
+		&List{contents: []interface{}{[]int{1}}},
+		&List{contents: []interface{}{[]int{1}}},
+		DeepEqualityComparer,
+	)))
+	a.True(ok(SequenceEqual(
+		&List{contents: []interface{}{[]int{1}, nil}},
+		&List{contents: []interface{}{[]int{1}, nil}},
+		nil,
+	)))
+}
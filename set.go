@@ -0,0 +1,266 @@
+package collections
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+)
+
+// EqualityComparer decides whether two values are equal, for values that are not valid go map keys
+// or that should be compared differently. Values that are equal must have the same HashCode.
+type EqualityComparer interface {
+	Equals(this, that interface{}) bool
+	HashCode(value interface{}) uint64
+}
+
+// DeepEqualityComparer compares values with reflect.DeepEqual, so it accepts slices, maps and
+// structs containing them.
+var DeepEqualityComparer EqualityComparer = deepEqualityComparer{}
+
+// The set operators below yield elements in the order they are first seen. A nil comparer compares elements
+// with ==, or with reflect.DeepEqual for values like slices and maps that == cannot compare, as Contains does.
+
+// Distinct returns a lazy enumerable of the collection without duplicates.
+func Distinct(collection Enumerable, comparer EqualityComparer) Enumerable {
+	return DistinctBy(collection, DefaultKeySelector, comparer)
+}
+
+// DistinctBy returns a lazy enumerable of the collection without elements whose key was already seen.
+func DistinctBy(collection Enumerable, keySelector KeySelector, comparer EqualityComparer) Enumerable {
+	return &deferredEnumerable{build: func() Enumerator {
+		seen := newHashSet(comparer)
+		return Filter(collection, func(value interface{}) bool {
+			return seen.Add(keySelector(value))
+		}).GetEnumerator()
+	}}
+}
+
+// Union returns a lazy enumerable of the distinct elements of first and then second.
+func Union(first, second Enumerable, comparer EqualityComparer) Enumerable {
+	return Distinct(&concatEnumerable{sources: []Enumerable{first, second}}, comparer)
+}
+
+// Intersect returns a lazy enumerable of the distinct elements of first that are also in second.
+func Intersect(first, second Enumerable, comparer EqualityComparer) Enumerable {
+	return &deferredEnumerable{build: func() Enumerator {
+		inSecond, err := newHashSetFrom(second, comparer)
+		if err != nil {
+			return &errorEnumerator{err: err}
+		}
+		return Filter(first, inSecond.Remove).GetEnumerator()
+	}}
+}
+
+// Except returns a lazy enumerable of the distinct elements of first that are not in second.
+func Except(first, second Enumerable, comparer EqualityComparer) Enumerable {
+	return &deferredEnumerable{build: func() Enumerator {
+		seen, err := newHashSetFrom(second, comparer)
+		if err != nil {
+			return &errorEnumerator{err: err}
+		}
+		return Filter(first, seen.Add).GetEnumerator()
+	}}
+}
+
+// SymmetricDifference returns a lazy enumerable of the distinct elements that are in exactly one of
+// first and second; those from first come before those from second.
+func SymmetricDifference(first, second Enumerable, comparer EqualityComparer) Enumerable {
+	return &deferredEnumerable{build: func() Enumerator {
+		inFirst, err := newHashSetFrom(first, comparer)
+		if err != nil {
+			return &errorEnumerator{err: err}
+		}
+		inSecond, err := newHashSetFrom(second, comparer)
+		if err != nil {
+			return &errorEnumerator{err: err}
+		}
+		seen := newHashSet(comparer)
+		return Filter(&concatEnumerable{sources: []Enumerable{first, second}}, func(value interface{}) bool {
+			return !(inFirst.Contains(value) && inSecond.Contains(value)) && seen.Add(value)
+		}).GetEnumerator()
+	}}
+}
+
+// --------------------------------------------------------------------------------
+// hashSet
+// --------------------------------------------------------------------------------
+
+// hashSet holds values as map keys, or in buckets by hash code when it has a comparer. Without a comparer,
+// values that cannot be map keys, like slices, are bucketed with DeepEqualityComparer.
+type hashSet struct {
+	comparer EqualityComparer
+	values   map[interface{}]struct{}
+	buckets  map[uint64][]interface{}
+	length   int
+}
+
+func newHashSet(comparer EqualityComparer) *hashSet {
+	return &hashSet{comparer: comparer, values: map[interface{}]struct{}{}, buckets: map[uint64][]interface{}{}}
+}
+
+func newHashSetFrom(collection Enumerable, comparer EqualityComparer) (*hashSet, error) {
+	hs := newHashSet(comparer)
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		hs.Add(e.GetCurrent())
+	}
+	return hs, enumeratorErr(e)
+}
+
+// Add adds the value and returns true if it was not already in the set.
+func (hs *hashSet) Add(value interface{}) bool {
+	comparer := hs.comparerFor(value)
+	if comparer == nil {
+		if _, hasValue := hs.values[value]; hasValue {
+			return false
+		}
+		hs.values[value] = struct{}{}
+		hs.length = hs.length + 1
+		return true
+	}
+
+	hashCode := comparer.HashCode(value)
+	if hs.indexInBucket(comparer, hashCode, value) >= 0 {
+		return false
+	}
+	hs.buckets[hashCode] = append(hs.buckets[hashCode], value)
+	hs.length = hs.length + 1
+	return true
+}
+
+func (hs *hashSet) Contains(value interface{}) bool {
+	comparer := hs.comparerFor(value)
+	if comparer == nil {
+		_, hasValue := hs.values[value]
+		return hasValue
+	}
+	return hs.indexInBucket(comparer, comparer.HashCode(value), value) >= 0
+}
+
+// Remove removes the value and returns true if it was in the set.
+func (hs *hashSet) Remove(value interface{}) bool {
+	comparer := hs.comparerFor(value)
+	if comparer == nil {
+		if _, hasValue := hs.values[value]; !hasValue {
+			return false
+		}
+		delete(hs.values, value)
+		hs.length = hs.length - 1
+		return true
+	}
+
+	hashCode := comparer.HashCode(value)
+	index := hs.indexInBucket(comparer, hashCode, value)
+	if index < 0 {
+		return false
+	}
+	bucket := hs.buckets[hashCode]
+	hs.buckets[hashCode] = append(bucket[:index], bucket[index+1:]...)
+	hs.length = hs.length - 1
+	return true
+}
+
+func (hs *hashSet) Len() int {
+	return hs.length
+}
+
+// comparerFor returns the comparer to bucket value with, or nil if it is kept as a map key.
+func (hs *hashSet) comparerFor(value interface{}) EqualityComparer {
+	if hs.comparer != nil {
+		return hs.comparer
+	}
+	if isComparable(value) {
+		return nil
+	}
+	return DeepEqualityComparer
+}
+
+func (hs *hashSet) indexInBucket(comparer EqualityComparer, hashCode uint64, value interface{}) int {
+	for index, candidate := range hs.buckets[hashCode] {
+		if comparer.Equals(candidate, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// --------------------------------------------------------------------------------
+// deepEqualityComparer
+// --------------------------------------------------------------------------------
+
+// deepHashDepth bounds how far deepHash follows nested values, which keeps it finite for cyclic values.
+const deepHashDepth = 8
+
+type deepEqualityComparer struct{}
+
+func (deepEqualityComparer) Equals(this, that interface{}) bool {
+	return reflect.DeepEqual(this, that)
+}
+
+func (deepEqualityComparer) HashCode(value interface{}) uint64 {
+	return deepHash(reflect.ValueOf(value), deepHashDepth)
+}
+
+// deepHash hashes a value consistently with reflect.DeepEqual: pointers are followed, and map
+// entries are combined without regard to their order.
+func deepHash(value reflect.Value, depth int) uint64 {
+	if !value.IsValid() {
+		return 0
+	}
+
+	h := fnv.New64a()
+	fmt.Fprint(h, value.Type().String())
+	hashCode := h.Sum64()
+	if depth == 0 {
+		return hashCode
+	}
+
+	combine := func(elementHash uint64) {
+		hashCode = hashCode*31 + elementHash
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			combine(deepHash(value.Elem(), depth-1))
+		}
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < value.Len(); index++ {
+			combine(deepHash(value.Index(index), depth-1))
+		}
+	case reflect.Struct:
+		for index := 0; index < value.NumField(); index++ {
+			combine(deepHash(value.Field(index), depth-1))
+		}
+	case reflect.Map:
+		var entries uint64
+		for _, key := range value.MapKeys() {
+			entries = entries + deepHash(key, depth-1)*17 + deepHash(value.MapIndex(key), depth-1)
+		}
+		combine(entries)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		combine(uint64(value.Pointer()))
+	case reflect.Float32, reflect.Float64:
+		h.Reset()
+		fmt.Fprint(h, positiveZero(value.Float()))
+		combine(h.Sum64())
+	case reflect.Complex64, reflect.Complex128:
+		h.Reset()
+		fmt.Fprint(h, positiveZero(real(value.Complex())), positiveZero(imag(value.Complex())))
+		combine(h.Sum64())
+	default:
+		h.Reset()
+		fmt.Fprint(h, value)
+		combine(h.Sum64())
+	}
+	return hashCode
+}
+
+// positiveZero returns value with -0 replaced by 0, which it is equal to and so has to hash the same as.
+func positiveZero(value float64) float64 {
+	if value == 0 {
+		return 0
+	}
+	return value
+}
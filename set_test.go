@@ -0,0 +1,113 @@
+package collections
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestDistinct(t *testing.T) {
+	a := assert.New(t)
+
+	distinct := Distinct(NewList(3, 1, 3, 2, 1), nil)
+	a.Equal([]interface{}{3, 1, 2}, ToList(distinct).(*List).contents)
+
+	e := distinct.GetEnumerator()
+	a.True(e.MoveNext())
+	a.True(e.MoveNext())
+	e.Reset()
+	a.True(e.MoveNext())
+	a.Equal(3, e.GetCurrent())
+}
+
+func TestDistinctBy(t *testing.T) {
+	a := assert.New(t)
+
+	distinct := DistinctBy(NewList("foo", "FOO", "bar", "Foo", "BAR"), func(value interface{}) interface{} {
+		return strings.ToLower(value.(string))
+	}, nil)
+	a.Equal([]interface{}{"foo", "bar"}, ToList(distinct).(*List).contents)
+}
+
+func TestDistinctDeepEquality(t *testing.T) {
+	a := assert.New(t)
+
+	l := &List{contents: []interface{}{[]int{1, 2}, []int{2, 1}, []int{1, 2}, map[string]int{"a": 1}, map[string]int{"a": 1}}}
+	distinct := Distinct(l, DeepEqualityComparer)
+	a.Equal([]interface{}{[]int{1, 2}, []int{2, 1}, map[string]int{"a": 1}}, ToList(distinct).(*List).contents)
+
+	withoutComparer := &List{contents: append([]interface{}{1, 1}, l.contents...)}
+	a.Equal([]interface{}{1, []int{1, 2}, []int{2, 1}, map[string]int{"a": 1}}, contentsOf(Distinct(withoutComparer, nil)))
+
+	slices := &List{contents: []interface{}{[]int{1}, []int{2}, 3}}
+	others := &List{contents: []interface{}{[]int{2}, 3, []int{4}}}
+	a.Equal([]interface{}{[]int{2}, 3}, contentsOf(Intersect(slices, others, nil)))
+	a.Equal([]interface{}{[]int{1}}, contentsOf(Except(slices, others, nil)))
+	a.Equal([]interface{}{[]int{1}, []int{2}, 3, []int{4}}, contentsOf(Union(slices, others, nil)))
+}
+
+func TestUnion(t *testing.T) {
+	a := assert.New(t)
+
+	union := Union(NewList(1, 2, 2, 3), NewList(3, 4, 1, 5), nil)
+	a.Equal([]interface{}{1, 2, 3, 4, 5}, ToList(union).(*List).contents)
+}
+
+func TestIntersect(t *testing.T) {
+	a := assert.New(t)
+
+	intersect := Intersect(NewList(4, 1, 2, 1, 3), NewList(3, 1, 5, 4), nil)
+	a.Equal([]interface{}{4, 1, 3}, ToList(intersect).(*List).contents)
+
+	e := intersect.GetEnumerator()
+	a.True(e.MoveNext())
+	e.Reset()
+	a.Equal(3, len(ToList(intersect).(*List).contents))
+}
+
+func TestExcept(t *testing.T) {
+	a := assert.New(t)
+
+	except := Except(NewList(1, 2, 2, 3, 4), NewList(3), nil)
+	a.Equal([]interface{}{1, 2, 4}, ToList(except).(*List).contents)
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	a := assert.New(t)
+
+	difference := SymmetricDifference(NewList(1, 2, 2, 3), NewList(3, 4, 4, 1), nil)
+	a.Equal([]interface{}{2, 4}, ToList(difference).(*List).contents)
+
+	deepDifference := SymmetricDifference(
+		&List{contents: []interface{}{[]string{"a"}, []string{"b"}}},
+		&List{contents: []interface{}{[]string{"b"}, []string{"c"}}},
+		DeepEqualityComparer,
+	)
+	a.Equal([]interface{}{[]string{"a"}, []string{"c"}}, ToList(deepDifference).(*List).contents)
+}
+
+func TestDeepEqualityComparerHashCode(t *testing.T) {
+	a := assert.New(t)
+
+	one, otherOne := 1, 1
+	a.Equal(DeepEqualityComparer.HashCode(&one), DeepEqualityComparer.HashCode(&otherOne))
+	a.Equal(
+		DeepEqualityComparer.HashCode(map[string][]int{"a": {1}, "b": {2}}),
+		DeepEqualityComparer.HashCode(map[string][]int{"b": {2}, "a": {1}}),
+	)
+	a.NotEqual(DeepEqualityComparer.HashCode([]int{1, 2}), DeepEqualityComparer.HashCode([]int{2, 1}))
+	a.NotEqual(DeepEqualityComparer.HashCode(1), DeepEqualityComparer.HashCode(int64(1)))
+}
+
+func TestDeepEqualityComparerNegativeZero(t *testing.T) {
+	a := assert.New(t)
+
+	negativeZero := math.Copysign(0, -1)
+	distinct := Distinct(&List{contents: []interface{}{[]float64{0}, []float64{negativeZero}}}, DeepEqualityComparer)
+	a.Len(contentsOf(distinct), 1)
+
+	a.Equal(DeepEqualityComparer.HashCode(float32(0)), DeepEqualityComparer.HashCode(float32(negativeZero)))
+	a.Equal(DeepEqualityComparer.HashCode(complex(0, 0)), DeepEqualityComparer.HashCode(complex(negativeZero, negativeZero)))
+}
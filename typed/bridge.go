@@ -0,0 +1,161 @@
+package typed
+
+import (
+	"reflect"
+
+	"github.com/blendlabs/go-exception"
+	collections "github.com/wcharczuk/go-collections"
+)
+
+// AsUntyped exposes a typed enumerable to code that expects a collections.Enumerable.
+func AsUntyped[T any](collection Enumerable[T]) collections.Enumerable {
+	return &untypedEnumerable[T]{source: collection}
+}
+
+// Cast exposes a collections.Enumerable as a typed enumerable.
+// Numeric elements that are not a T are converted when the conversion is exact. The enumeration stops at an
+// element that cannot be converted, or would lose precision, and the enumerator's Err method returns the error.
+func Cast[T any](collection collections.Enumerable) Enumerable[T] {
+	if typedCollection, isTyped := collection.(*untypedEnumerable[T]); isTyped {
+		return typedCollection.source
+	}
+	return &castEnumerable[T]{source: collection}
+}
+
+// --------------------------------------------------------------------------------
+// untypedEnumerable
+// --------------------------------------------------------------------------------
+
+type untypedEnumerable[T any] struct {
+	source Enumerable[T]
+}
+
+func (ue *untypedEnumerable[T]) GetEnumerator() collections.Enumerator {
+	return &untypedEnumerator[T]{source: ue.source.GetEnumerator()}
+}
+
+type untypedEnumerator[T any] struct {
+	source Enumerator[T]
+	valid  bool
+}
+
+func (ue *untypedEnumerator[T]) MoveNext() bool {
+	ue.valid = ue.source.MoveNext()
+	return ue.valid
+}
+
+// GetCurrent returns nil rather than the zero T when the enumerator is not on an element.
+func (ue *untypedEnumerator[T]) GetCurrent() interface{} {
+	if !ue.valid {
+		return nil
+	}
+	return ue.source.GetCurrent()
+}
+
+func (ue *untypedEnumerator[T]) Err() error {
+	return enumeratorErr(ue.source)
+}
+
+func (ue *untypedEnumerator[T]) Close() error {
+	return closeEnumerator(ue.source)
+}
+
+func (ue *untypedEnumerator[T]) Reset() {
+	ue.valid = false
+	ue.source.Reset()
+}
+
+// --------------------------------------------------------------------------------
+// castEnumerable
+// --------------------------------------------------------------------------------
+
+type castEnumerable[T any] struct {
+	source collections.Enumerable
+}
+
+func (ce *castEnumerable[T]) GetEnumerator() Enumerator[T] {
+	return &castEnumerator[T]{source: ce.source.GetEnumerator()}
+}
+
+type castEnumerator[T any] struct {
+	source  collections.Enumerator
+	valid   bool
+	current T
+	err     error
+}
+
+func (ce *castEnumerator[T]) MoveNext() bool {
+	var zero T
+	ce.valid, ce.current = false, zero
+	if ce.err != nil || !ce.source.MoveNext() {
+		return false
+	}
+
+	current, err := castAs[T](ce.source.GetCurrent())
+	if err != nil {
+		ce.err = err
+		return false
+	}
+	ce.valid, ce.current = true, current
+	return true
+}
+
+func (ce *castEnumerator[T]) GetCurrent() T {
+	return ce.current
+}
+
+// Err returns the error from casting an element, or else the error from the source if it is a
+// collections.ErrEnumerator.
+func (ce *castEnumerator[T]) Err() error {
+	if ce.err != nil {
+		return ce.err
+	}
+	if typedSource, hasErr := ce.source.(collections.ErrEnumerator); hasErr {
+		return typedSource.Err()
+	}
+	return nil
+}
+
+// Close closes the source if it is a collections.ClosableEnumerator.
+func (ce *castEnumerator[T]) Close() error {
+	if typedSource, isClosable := ce.source.(collections.ClosableEnumerator); isClosable {
+		return typedSource.Close()
+	}
+	return nil
+}
+
+func (ce *castEnumerator[T]) Reset() {
+	var zero T
+	ce.valid, ce.current, ce.err = false, zero, nil
+	ce.source.Reset()
+}
+
+// castAs returns value as a T, converting a numeric value with collections.ConvertNumber.
+func castAs[T any](value interface{}) (T, error) {
+	var zero T
+	destinationType := reflect.TypeOf(&zero).Elem()
+	if value == nil {
+		if !isNillable(destinationType) {
+			return zero, exception.Newf("Cannot cast nil as %v", destinationType)
+		}
+		return zero, nil
+	}
+
+	if typedValue, isTyped := value.(T); isTyped {
+		return typedValue, nil
+	}
+
+	converted, err := collections.ConvertNumber(value, destinationType)
+	if err != nil {
+		return zero, err
+	}
+	return converted.(T), nil
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	}
+	return false
+}
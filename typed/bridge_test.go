@@ -0,0 +1,123 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	collections "github.com/wcharczuk/go-collections"
+)
+
+func TestAsUntyped(t *testing.T) {
+	a := assert.New(t)
+
+	untyped := AsUntyped[int](NewList(1, 2, 3))
+	e := untyped.GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+	a.True(e.MoveNext())
+	a.Equal(2, e.GetCurrent())
+	a.True(e.MoveNext())
+	a.Equal(3, e.GetCurrent())
+	a.False(e.MoveNext())
+}
+
+func TestCast(t *testing.T) {
+	a := assert.New(t)
+
+	doubled := Map(Cast[int](collections.NewList(1, 2, 3)), func(value int) int {
+		return value * 2
+	})
+	a.Equal([]int{2, 4, 6}, ToList(doubled).ToSlice())
+
+	widened := ToList(Cast[float64](collections.NewList(1, int64(2), float32(3))))
+	a.Equal([]float64{1, 2, 3}, widened.ToSlice())
+}
+
+func TestCastRoundTrip(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3)
+	a.Equal(Enumerable[int](l), Cast[int](AsUntyped[int](l)))
+}
+
+func TestCastInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	e := Cast[int](collections.NewList(1, "foo", 3)).GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+	a.False(e.MoveNext())
+	a.Equal(0, e.GetCurrent())
+	a.NotNil(enumeratorErr(e))
+	a.False(e.MoveNext())
+
+	e.Reset()
+	a.Nil(enumeratorErr(e))
+	a.True(e.MoveNext())
+	a.Equal(1, e.GetCurrent())
+}
+
+func TestCastLosingPrecision(t *testing.T) {
+	a := assert.New(t)
+
+	for _, e := range []interface{ Err() error }{
+		drain(Cast[int](collections.NewList(2.5))),
+		drain(Cast[uint8](collections.NewList(300))),
+		drain(Cast[uint](collections.NewList(-1))),
+		drain(Cast[float64](collections.NewList(int64(1<<53 + 1)))),
+		drain(Cast[float32](collections.NewList(0.1))),
+	} {
+		a.NotNil(e.Err())
+	}
+
+	a.Equal([]int{2, 300}, ToList(Cast[int](collections.NewList(2.0, uint16(300)))).ToSlice())
+	a.Equal([]uint8{255}, ToList(Cast[uint8](collections.NewList(int64(255)))).ToSlice())
+}
+
+// drain moves the enumerator of the collection to its end and returns it.
+func drain[T any](collection Enumerable[T]) interface{ Err() error } {
+	e := collection.GetEnumerator()
+	for e.MoveNext() {
+	}
+	return e.(interface{ Err() error })
+}
+
+func TestCastForwardsErr(t *testing.T) {
+	a := assert.New(t)
+
+	failing := collections.Concat(collections.NewList(1), collections.SortBy(collections.NewList("a", 1), collections.DefaultKeySelector))
+
+	e := Map(Cast[int](failing), func(value int) int { return value * 2 }).GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal(2, e.GetCurrent())
+	a.False(e.MoveNext())
+	a.NotNil(enumeratorErr(e))
+}
+
+func TestCastErrorsReachSinks(t *testing.T) {
+	a := assert.New(t)
+
+	source := collections.NewList(1, 2.5, 3)
+
+	values, err := Collect(Cast[int](source))
+	a.NotNil(err)
+	a.Equal([]int{1}, values.ToSlice())
+
+	_, found, err := First(Cast[int](source), func(value int) bool { return value == 3 })
+	a.NotNil(err)
+	a.False(found)
+
+	_, err = PeekBack(Cast[int](source))
+	a.NotNil(err)
+
+	e := SortBy(Cast[int](source), DefaultKeySelector[int]).GetEnumerator()
+	a.False(e.MoveNext())
+	a.NotNil(enumeratorErr(e))
+
+	_, err = Collect(Cast[int](collections.NewList(1, nil)))
+	a.NotNil(err)
+
+	pointers, err := Collect(Cast[*int](collections.NewList(nil)))
+	a.Nil(err)
+	a.Equal([]*int{nil}, pointers.ToSlice())
+}
@@ -0,0 +1,26 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-collections/collectionstest"
+)
+
+func TestEnumeratorConformance(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4)
+	a.Nil(collectionstest.TestEnumerator(AsUntyped[int](l).GetEnumerator(), 1, 2, 3, 4))
+	a.Nil(collectionstest.TestEnumerator(AsUntyped[int](NewList[int]()).GetEnumerator()))
+
+	doubled := Map(Enumerable[int](l), func(value int) int {
+		return value * 2
+	})
+	a.Nil(collectionstest.TestEnumerator(AsUntyped(doubled).GetEnumerator(), 2, 4, 6, 8))
+
+	even := Filter(Enumerable[int](l), func(value int) bool {
+		return value%2 == 0
+	})
+	a.Nil(collectionstest.TestEnumerator(AsUntyped(even).GetEnumerator(), 2, 4))
+}
@@ -0,0 +1,91 @@
+package typed
+
+// --------------------------------------------------------------------------------
+// exported interfaces
+// --------------------------------------------------------------------------------
+
+// Enumerable is the type-parameterized counterpart of collections.Enumerable.
+type Enumerable[T any] interface {
+	GetEnumerator() Enumerator[T]
+}
+
+// Enumerator is the type-parameterized counterpart of collections.Enumerator and follows the same
+// protocol: MoveNext must be called before the first GetCurrent.
+type Enumerator[T any] interface {
+	MoveNext() bool
+	GetCurrent() T
+	Reset()
+}
+
+// enumeratorErr returns the error from an enumerator with an Err method, like collections.ErrEnumerator,
+// or nil for any other Enumerator.
+func enumeratorErr[T any](e Enumerator[T]) error {
+	if typed, hasErr := e.(interface{ Err() error }); hasErr {
+		return typed.Err()
+	}
+	return nil
+}
+
+// closeEnumerator closes an enumerator with a Close method, like collections.ClosableEnumerator,
+// and does nothing for any other Enumerator.
+func closeEnumerator[T any](e Enumerator[T]) error {
+	if typed, isClosable := e.(interface{ Close() error }); isClosable {
+		return typed.Close()
+	}
+	return nil
+}
+
+// errorEnumerator is an empty enumerator that reports an error, for operators that fail before
+// they yield anything.
+type errorEnumerator[T any] struct {
+	err error
+}
+
+func (ee *errorEnumerator[T]) MoveNext() bool {
+	return false
+}
+
+func (ee *errorEnumerator[T]) GetCurrent() T {
+	var zero T
+	return zero
+}
+
+func (ee *errorEnumerator[T]) Err() error {
+	return ee.err
+}
+
+func (ee *errorEnumerator[T]) Reset() {}
+
+// --------------------------------------------------------------------------------
+// sliceEnumerator
+// --------------------------------------------------------------------------------
+
+type sliceEnumerator[T any] struct {
+	index    int
+	contents []T
+}
+
+func NewSliceEnumerator[T any](contents []T) *sliceEnumerator[T] {
+	return &sliceEnumerator[T]{index: -1, contents: contents}
+}
+
+func (se *sliceEnumerator[T]) MoveNext() bool {
+	if se.index+1 >= len(se.contents) {
+		se.index = len(se.contents)
+		return false
+	}
+	se.index = se.index + 1
+	return true
+}
+
+func (se *sliceEnumerator[T]) GetCurrent() T {
+	if se.index >= 0 && se.index < len(se.contents) {
+		return se.contents[se.index]
+	}
+	var zero T
+	return zero
+}
+
+func (se *sliceEnumerator[T]) Reset() {
+	se.index = -1
+}
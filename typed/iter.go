@@ -0,0 +1,93 @@
+package typed
+
+import "iter"
+
+// All returns an iterator over the elements of the collection, for use with range.
+// An error from the collection ends the iteration early; use AllWithError where it has to be reported.
+func All[T any](collection Enumerable[T]) iter.Seq[T] {
+	values, _ := AllWithError(collection)
+	return values
+}
+
+// AllWithError is All, and also returns a function that returns the error, if any, that ended the last
+// iteration of the collection.
+func AllWithError[T any](collection Enumerable[T]) (iter.Seq[T], func() error) {
+	var err error
+	values := func(yield func(T) bool) {
+		e := collection.GetEnumerator()
+		defer closeEnumerator(e)
+		err = nil
+		for e.MoveNext() {
+			if !yield(e.GetCurrent()) {
+				return
+			}
+		}
+		err = enumeratorErr(e)
+	}
+	return values, func() error { return err }
+}
+
+// FromSeq returns an Enumerable over the values yielded by seq.
+// An enumerator that is abandoned before MoveNext returns false holds on to the
+// suspended iterator; call Close on it to release it.
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	return &seqEnumerable[T]{seq: seq}
+}
+
+// --------------------------------------------------------------------------------
+// seqEnumerable
+// --------------------------------------------------------------------------------
+
+type seqEnumerable[T any] struct {
+	seq iter.Seq[T]
+}
+
+func (se *seqEnumerable[T]) GetEnumerator() Enumerator[T] {
+	return &seqEnumerator[T]{seq: se.seq}
+}
+
+type seqEnumerator[T any] struct {
+	seq     iter.Seq[T]
+	next    func() (T, bool)
+	stop    func()
+	done    bool
+	current T
+}
+
+func (se *seqEnumerator[T]) MoveNext() bool {
+	if se.done {
+		return false
+	}
+	if se.next == nil {
+		se.next, se.stop = iter.Pull(se.seq)
+	}
+
+	var valid bool
+	se.current, valid = se.next()
+	if !valid {
+		se.done = true
+		se.stop()
+	}
+	return valid
+}
+
+func (se *seqEnumerator[T]) GetCurrent() T {
+	return se.current
+}
+
+// Close stops the iterator; MoveNext returns false until the enumerator is Reset.
+func (se *seqEnumerator[T]) Close() error {
+	if se.stop != nil {
+		se.stop()
+	}
+	se.done = true
+	return nil
+}
+
+func (se *seqEnumerator[T]) Reset() {
+	if se.stop != nil {
+		se.stop()
+	}
+	var zero T
+	se.next, se.stop, se.done, se.current = nil, nil, false, zero
+}
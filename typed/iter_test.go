@@ -0,0 +1,37 @@
+package typed
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	collections "github.com/wcharczuk/go-collections"
+)
+
+func TestAll(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]int{1, 2, 3}, slices.Collect(All(Enumerable[int](NewList(1, 2, 3)))))
+}
+
+func TestAllWithError(t *testing.T) {
+	a := assert.New(t)
+
+	all, err := AllWithError(Cast[int](collections.NewList(1, "two", 3)))
+	a.Equal([]int{1}, slices.Collect(all))
+	a.NotNil(err())
+
+	all, err = AllWithError(Enumerable[int](NewList(1, 2)))
+	a.Equal([]int{1, 2}, slices.Collect(all))
+	a.Nil(err())
+}
+
+func TestFromSeq(t *testing.T) {
+	a := assert.New(t)
+
+	doubled := Map(FromSeq(slices.Values([]int{1, 2, 3})), func(value int) int {
+		return value * 2
+	})
+	a.Equal([]int{2, 4, 6}, slices.Collect(All(doubled)))
+	a.Equal([]int{2, 4, 6}, ToList(doubled).ToSlice())
+}
@@ -0,0 +1,245 @@
+package typed
+
+import (
+	"cmp"
+	"sort"
+)
+
+// KeySelector returns a field from a given value
+type KeySelector[T, K any] func(value T) K
+
+// MapAction is the projection from the value to a different value
+type MapAction[T, U any] func(value T) U
+
+// Predicate is a function that takes a value and returns a true or false
+type Predicate[T any] func(value T) bool
+
+func DefaultKeySelector[T any](value T) T {
+	return value
+}
+
+// Map returns a lazy enumerable that projects each element with mapFn as it is enumerated.
+func Map[T, U any](collection Enumerable[T], mapFn MapAction[T, U]) Enumerable[U] {
+	return &selectEnumerable[T, U]{source: collection, mapFn: mapFn}
+}
+
+// Filter returns a lazy enumerable of the elements that match the predicate.
+func Filter[T any](collection Enumerable[T], predicate Predicate[T]) Enumerable[T] {
+	return &whereEnumerable[T]{source: collection, predicate: predicate}
+}
+
+// SortBy returns a lazy enumerable of the elements of the collection in ascending order of sortKey, with equal
+// keys in their original order. The collection is read and sorted when it is enumerated; if it fails, the
+// enumerator is empty and its Err method returns the error.
+func SortBy[T any, K cmp.Ordered](collection Enumerable[T], sortKey KeySelector[T, K]) Enumerable[T] {
+	return &sortedEnumerable[T, K]{source: collection, sortKey: sortKey}
+}
+
+// SortByDescending is SortBy with the keys in descending order.
+func SortByDescending[T any, K cmp.Ordered](collection Enumerable[T], sortKey KeySelector[T, K]) Enumerable[T] {
+	return &sortedEnumerable[T, K]{source: collection, sortKey: sortKey, descending: true}
+}
+
+// Peek returns the first element of the collection, or the zero T if it is empty.
+func Peek[T any](collection Enumerable[T]) (T, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	if e.MoveNext() {
+		return e.GetCurrent(), nil
+	}
+	var zero T
+	return zero, enumeratorErr(e)
+}
+
+// PeekBack returns the last element of the collection, or the zero T if it is empty.
+func PeekBack[T any](collection Enumerable[T]) (T, error) {
+	values, err := Collect(collection)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return values.Last(), nil
+}
+
+// First returns the first element that matches the predicate, and whether one was found,
+// or the error that stopped the enumeration.
+func First[T any](collection Enumerable[T], predicate Predicate[T]) (T, bool, error) {
+	e := collection.GetEnumerator()
+	defer closeEnumerator(e)
+	for e.MoveNext() {
+		current := e.GetCurrent()
+		if predicate(current) {
+			return current, true, nil
+		}
+	}
+	var zero T
+	return zero, false, enumeratorErr(e)
+}
+
+// ToList copies the collection into a new list; if it is already a list it is returned as is.
+// The list stops at an error from the collection, which is dropped; use Collect to get it.
+func ToList[T any](collection Enumerable[T]) *List[T] {
+	values, _ := Collect(collection)
+	return values
+}
+
+// Collect is ToList for collections that can fail: it also returns the error that stopped the enumeration,
+// or else the error from closing the enumerator, in which case the list holds the elements read before it.
+func Collect[T any](collection Enumerable[T]) (*List[T], error) {
+	if typedCollection, isList := collection.(*List[T]); isList {
+		return typedCollection, nil
+	}
+
+	newList := &List[T]{}
+	e := collection.GetEnumerator()
+	for e.MoveNext() {
+		newList.Add(e.GetCurrent())
+	}
+	err := enumeratorErr(e)
+	if closeErr := closeEnumerator(e); err == nil {
+		err = closeErr
+	}
+	return newList, err
+}
+
+// --------------------------------------------------------------------------------
+// internal Types
+// --------------------------------------------------------------------------------
+
+type selectEnumerable[T, U any] struct {
+	source Enumerable[T]
+	mapFn  MapAction[T, U]
+}
+
+func (se *selectEnumerable[T, U]) GetEnumerator() Enumerator[U] {
+	return &selectEnumerator[T, U]{source: se.source.GetEnumerator(), mapFn: se.mapFn}
+}
+
+type selectEnumerator[T, U any] struct {
+	source     Enumerator[T]
+	mapFn      MapAction[T, U]
+	valid      bool
+	current    U
+	hasCurrent bool
+}
+
+func (se *selectEnumerator[T, U]) MoveNext() bool {
+	se.hasCurrent = false
+	se.valid = se.source.MoveNext()
+	return se.valid
+}
+
+func (se *selectEnumerator[T, U]) GetCurrent() U {
+	if !se.valid {
+		var zero U
+		return zero
+	}
+	if !se.hasCurrent {
+		se.current, se.hasCurrent = se.mapFn(se.source.GetCurrent()), true
+	}
+	return se.current
+}
+
+func (se *selectEnumerator[T, U]) Err() error {
+	return enumeratorErr(se.source)
+}
+
+func (se *selectEnumerator[T, U]) Close() error {
+	return closeEnumerator(se.source)
+}
+
+func (se *selectEnumerator[T, U]) Reset() {
+	se.valid, se.hasCurrent = false, false
+	se.source.Reset()
+}
+
+type whereEnumerable[T any] struct {
+	source    Enumerable[T]
+	predicate Predicate[T]
+}
+
+func (we *whereEnumerable[T]) GetEnumerator() Enumerator[T] {
+	return &whereEnumerator[T]{source: we.source.GetEnumerator(), predicate: we.predicate}
+}
+
+type whereEnumerator[T any] struct {
+	source    Enumerator[T]
+	predicate Predicate[T]
+}
+
+func (we *whereEnumerator[T]) MoveNext() bool {
+	for we.source.MoveNext() {
+		if we.predicate(we.source.GetCurrent()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (we *whereEnumerator[T]) GetCurrent() T {
+	return we.source.GetCurrent()
+}
+
+func (we *whereEnumerator[T]) Err() error {
+	return enumeratorErr(we.source)
+}
+
+func (we *whereEnumerator[T]) Close() error {
+	return closeEnumerator(we.source)
+}
+
+func (we *whereEnumerator[T]) Reset() {
+	we.source.Reset()
+}
+
+type sortedEnumerable[T any, K cmp.Ordered] struct {
+	source     Enumerable[T]
+	sortKey    KeySelector[T, K]
+	descending bool
+}
+
+func (se *sortedEnumerable[T, K]) GetEnumerator() Enumerator[T] {
+	values, err := Collect(se.source)
+	if err != nil {
+		return &errorEnumerator[T]{err: err}
+	}
+
+	sorted := NewList(values.contents...)
+	sort.Stable(newSortableList(sorted, se.sortKey, se.descending))
+	return sorted.GetEnumerator()
+}
+
+func newSortableList[T any, K cmp.Ordered](contents *List[T], sortKey KeySelector[T, K], descending bool) *sortableList[T, K] {
+	keys := make([]K, contents.Len())
+	for index, value := range contents.contents {
+		keys[index] = sortKey(value)
+	}
+
+	return &sortableList[T, K]{
+		contents:   contents,
+		keys:       keys,
+		descending: descending,
+	}
+}
+
+type sortableList[T any, K cmp.Ordered] struct {
+	contents   *List[T]
+	keys       []K
+	descending bool
+}
+
+func (s *sortableList[T, K]) Len() int {
+	return s.contents.Len()
+}
+
+func (s *sortableList[T, K]) Swap(i, j int) {
+	s.contents.Swap(i, j)
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func (s *sortableList[T, K]) Less(i, j int) bool {
+	if s.descending {
+		return cmp.Less(s.keys[j], s.keys[i])
+	}
+	return cmp.Less(s.keys[i], s.keys[j])
+}
@@ -0,0 +1,93 @@
+package typed
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestLinqMap(t *testing.T) {
+	a := assert.New(t)
+
+	mapped := ToList(Map(NewList(1, 2, 3, 4), func(value int) string {
+		return strconv.Itoa(value * 2)
+	}))
+
+	a.Equal([]string{"2", "4", "6", "8"}, mapped.ToSlice())
+}
+
+func TestLinqFilter(t *testing.T) {
+	a := assert.New(t)
+
+	filtered := ToList(Filter(NewList(1, 2, 3, 4), func(value int) bool {
+		return value < 3
+	}))
+
+	a.Equal([]int{1, 2}, filtered.ToSlice())
+}
+
+func TestLinqLazy(t *testing.T) {
+	a := assert.New(t)
+
+	mapCalls := 0
+	mapped := Map(Enumerable[int](NewList(1, 2, 3, 4)), func(value int) int {
+		mapCalls = mapCalls + 1
+		return value * 10
+	})
+	a.Equal(0, mapCalls)
+
+	value, found, err := First(mapped, func(value int) bool {
+		return value == 20
+	})
+	a.Nil(err)
+	a.True(found)
+	a.Equal(20, value)
+	a.Equal(2, mapCalls)
+}
+
+func TestLinqFirst(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4)
+
+	value, found, err := First(Enumerable[int](l), func(value int) bool {
+		return value > 2
+	})
+	a.Nil(err)
+	a.True(found)
+	a.Equal(3, value)
+
+	_, found, err = First(Enumerable[int](l), func(value int) bool {
+		return value > 4
+	})
+	a.Nil(err)
+	a.False(found)
+}
+
+type myTestType struct {
+	Id   int
+	Name string
+}
+
+func TestLinqSortStructs(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(myTestType{Id: 3, Name: "Foo"}, myTestType{Id: 1, Name: "Bar"}, myTestType{Id: 2, Name: "Baz"})
+
+	sorted := ToList(SortBy(Enumerable[myTestType](l), func(v myTestType) int {
+		return v.Id
+	}))
+	a.Equal(1, sorted.At(0).Id)
+	a.Equal(2, sorted.At(1).Id)
+	a.Equal(3, sorted.At(2).Id)
+
+	descending := ToList(SortByDescending(Enumerable[myTestType](l), func(v myTestType) string {
+		return v.Name
+	}))
+	a.Equal("Foo", descending.At(0).Name)
+	a.Equal("Baz", descending.At(1).Name)
+	a.Equal("Bar", descending.At(2).Name)
+
+	a.Equal(3, l.At(0).Id, "the source should not be reordered")
+}
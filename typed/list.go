@@ -0,0 +1,60 @@
+package typed
+
+import "github.com/blendlabs/go-exception"
+
+func NewList[T any](contents ...T) *List[T] {
+	l := &List[T]{}
+	l.contents = append(l.contents, contents...)
+	return l
+}
+
+type List[T any] struct {
+	contents []T
+}
+
+func (l *List[T]) Add(item T) {
+	l.contents = append(l.contents, item)
+}
+
+func (l *List[T]) At(index int) T {
+	return l.contents[index]
+}
+
+func (l *List[T]) Last() T {
+	if len(l.contents) == 0 {
+		var zero T
+		return zero
+	}
+
+	return l.contents[l.Len()-1]
+}
+
+func (l *List[T]) Len() int {
+	return len(l.contents)
+}
+
+func (l *List[T]) RemoveAt(index int) error {
+	if index < 0 || index >= l.Len() {
+		return exception.Newf("Invalid index for RemoveAt(%d)", index)
+	}
+
+	l.contents = append(l.contents[0:index], l.contents[index+1:]...)
+	return nil
+}
+
+func (l *List[T]) Clear() {
+	l.contents = []T{}
+}
+
+func (l *List[T]) GetEnumerator() Enumerator[T] {
+	return NewSliceEnumerator(l.contents)
+}
+
+func (l *List[T]) Swap(i, j int) {
+	l.contents[i], l.contents[j] = l.contents[j], l.contents[i]
+}
+
+// ToSlice returns a copy of the list contents.
+func (l *List[T]) ToSlice() []T {
+	return append([]T{}, l.contents...)
+}
@@ -0,0 +1,42 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestListAdd(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4)
+	a.Equal(4, l.Len())
+
+	l.Add(5)
+	a.Equal(5, l.Len())
+	a.Equal(5, l.At(4))
+	a.Equal(5, l.Last())
+}
+
+func TestListRemoveAt(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList(1, 2, 3, 4)
+
+	a.Nil(l.RemoveAt(1))
+	a.Equal([]int{1, 3, 4}, l.ToSlice())
+	a.NotNil(l.RemoveAt(3))
+	a.NotNil(l.RemoveAt(-1))
+}
+
+func TestListGetEnumerator(t *testing.T) {
+	a := assert.New(t)
+
+	l := NewList("foo", "bar")
+	e := l.GetEnumerator()
+	a.True(e.MoveNext())
+	a.Equal("foo", e.GetCurrent())
+	a.True(e.MoveNext())
+	a.Equal("bar", e.GetCurrent())
+	a.False(e.MoveNext())
+}
@@ -88,7 +88,19 @@ func elementAtKey(contents interface{}, key interface{}) interface{} {
 	}
 
 	contentsValue := reflect.ValueOf(contents)
-	return contentsValue.MapIndex(reflect.ValueOf(key)).Interface()
+	value := contentsValue.MapIndex(mapKeyOf(contentsValue, key))
+	if !value.IsValid() {
+		return nil
+	}
+	return value.Interface()
+}
+
+// mapKeyOf returns key as a reflect.Value that can be looked up in the map, including a nil key.
+func mapKeyOf(contentsValue reflect.Value, key interface{}) reflect.Value {
+	if key == nil {
+		return reflect.Zero(contentsValue.Type().Key())
+	}
+	return reflect.ValueOf(key)
 }
 
 func getMapKeys(contents interface{}) []interface{} {
@@ -105,3 +117,37 @@ func getMapKeys(contents interface{}) []interface{} {
 	}
 	return keys
 }
+
+// getMapEntries returns the entries of the map as KeyValuePairs, in go's unspecified map order.
+func getMapEntries(contents interface{}) []KeyValuePair {
+	if contents == nil {
+		return nil
+	}
+
+	contentsValue := reflect.ValueOf(contents)
+	entries := make([]KeyValuePair, 0, contentsValue.Len())
+	for iterator := contentsValue.MapRange(); iterator.Next(); {
+		entries = append(entries, KeyValuePair{Key: iterator.Key().Interface(), Value: iterator.Value().Interface()})
+	}
+	return entries
+}
+
+// refreshMapEntries updates the values of entries from the map if it has exactly their keys, and returns
+// whether it did; otherwise entries is left unchanged.
+func refreshMapEntries(contents interface{}, entries []KeyValuePair) bool {
+	if getMapLength(contents) != len(entries) {
+		return false
+	}
+
+	contentsValue := reflect.ValueOf(contents)
+	values := make([]reflect.Value, len(entries))
+	for index, entry := range entries {
+		if values[index] = contentsValue.MapIndex(mapKeyOf(contentsValue, entry.Key)); !values[index].IsValid() {
+			return false
+		}
+	}
+	for index, value := range values {
+		entries[index].Value = value.Interface()
+	}
+	return true
+}